@@ -0,0 +1,177 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/specture-system/specture/internal/format"
+	specpkg "github.com/specture-system/specture/internal/spec"
+	"github.com/spf13/cobra"
+)
+
+var changedBaseFlag string
+var changedHeadFlag string
+var changedFormatFlag string
+
+var changedCmd = &cobra.Command{
+	Use:   "changed",
+	Args:  cobra.NoArgs,
+	Short: "List specs changed between git revisions",
+	Long: `List the specs that were added, removed, renamed, or modified between two
+git revisions, with their status before and after.
+
+Like a pull request, --head (default HEAD) is compared against its merge base
+with --base, so only the changes made on the head side are listed. A spec
+counts as changed when its SPEC.md or PLAN.md changed; a renamed spec is one
+whose directory moved, such as with specture rename. Revisions are read with
+local git commands, so fetch remote branches first.
+
+The text output lists each spec's change, ref, title, status (old → new when
+it changed), and path. Use -f json for review bots; each change carries kind,
+ref, name, status, path, the old_ref, old_name, old_status, and old_path at
+the merge base, status_changed (true when a spec present on both sides changed
+status), and the changed files.
+
+Examples:
+  specture changed --base origin/main
+  specture changed --base origin/main --head feature-branch
+  specture changed --base v1.0 -f json`,
+	RunE: runChanged,
+}
+
+func init() {
+	changedCmd.Flags().StringVar(&changedBaseFlag, "base", "", "Git revision to compare against (e.g., origin/main)")
+	changedCmd.Flags().StringVar(&changedHeadFlag, "head", "HEAD", "Git revision with the changes")
+	changedCmd.Flags().StringVarP(&changedFormatFlag, "format", "f", "text", "Output format: text or json")
+}
+
+func runChanged(cmd *cobra.Command, args []string) error {
+	format, _ := cmd.Flags().GetString("format")
+	if format != "text" && format != "json" {
+		return fmt.Errorf("invalid format: %s (must be 'text' or 'json')", format)
+	}
+	base, _ := cmd.Flags().GetString("base")
+	if base == "" {
+		return fmt.Errorf("--base is required")
+	}
+	head, _ := cmd.Flags().GetString("head")
+
+	specsDir, err := resolveSpecsDir()
+	if err != nil {
+		return err
+	}
+
+	changes, err := specpkg.Changes(specsDir, base, head)
+	if err != nil {
+		return err
+	}
+
+	if format == "json" {
+		return formatChangedJSON(cmd, base, head, changes)
+	}
+	return formatChangedText(cmd, base, head, changes)
+}
+
+// formatChangedText prints one aligned row per changed spec.
+func formatChangedText(cmd *cobra.Command, base, head string, changes []specpkg.Change) error {
+	if len(changes) == 0 {
+		cmd.Printf("No spec changes between %s and %s\n", base, head)
+		return nil
+	}
+
+	table := format.Table{Columns: []format.Column{
+		{Name: "change", Header: "CHANGE"},
+		{Name: "ref", Header: "REF"},
+		{Name: "name", Header: "NAME"},
+		{Name: "status", Header: "STATUS"},
+		{Name: "path", Header: "PATH"},
+	}}
+	for _, change := range changes {
+		table.Rows = append(table.Rows, []format.Cell{
+			format.Value(change.Kind),
+			format.Value(changedPair(change.OldRef, change.Ref)),
+			format.Value(changedValue(change.Name, change.OldName)),
+			format.Value(changedPair(change.OldStatus, change.Status)),
+			format.Value(changedPair(change.OldPath, change.Path)),
+		})
+	}
+
+	text, err := format.Get("text")
+	if err != nil {
+		return err
+	}
+	cmd.Printf("Spec changes between %s and %s (%d):\n\n", base, head, len(changes))
+	return text.Write(cmd.OutOrStdout(), table)
+}
+
+// changedPair formats a value before and after a change as "old → new",
+// or as the single value when it did not change or one side is missing.
+func changedPair(old, new string) string {
+	if old == "" || new == "" || old == new {
+		return changedValue(new, old)
+	}
+	return old + " → " + new
+}
+
+// changedValue returns value, or fallback when value is empty.
+func changedValue(value, fallback string) string {
+	if value == "" {
+		return fallback
+	}
+	return value
+}
+
+// changedJSONOutput is the JSON shape of the changed command.
+type changedJSONOutput struct {
+	Base    string             `json:"base"`
+	Head    string             `json:"head"`
+	Changes []changeJSONOutput `json:"changes"`
+}
+
+// changeJSONOutput describes one changed spec. The new fields are empty for
+// removed specs and the old fields for added specs.
+type changeJSONOutput struct {
+	Kind          string   `json:"kind"`
+	Ref           string   `json:"ref"`
+	Name          string   `json:"name"`
+	Status        string   `json:"status"`
+	Path          string   `json:"path"`
+	OldRef        string   `json:"old_ref"`
+	OldName       string   `json:"old_name"`
+	OldStatus     string   `json:"old_status"`
+	OldPath       string   `json:"old_path"`
+	StatusChanged bool     `json:"status_changed"`
+	Files         []string `json:"files"`
+}
+
+// formatChangedJSON outputs the changed specs as JSON.
+func formatChangedJSON(cmd *cobra.Command, base, head string, changes []specpkg.Change) error {
+	output := changedJSONOutput{
+		Base:    base,
+		Head:    head,
+		Changes: make([]changeJSONOutput, 0, len(changes)),
+	}
+	for _, change := range changes {
+		output.Changes = append(output.Changes, changeJSONOutput{
+			Kind:          change.Kind,
+			Ref:           change.Ref,
+			Name:          change.Name,
+			Status:        change.Status,
+			Path:          change.Path,
+			OldRef:        change.OldRef,
+			OldName:       change.OldName,
+			OldStatus:     change.OldStatus,
+			OldPath:       change.OldPath,
+			StatusChanged: change.OldStatus != "" && change.Status != "" && change.OldStatus != change.Status,
+			Files:         nonNilStrings(change.Files),
+		})
+	}
+
+	data, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+
+	cmd.Println(string(data))
+	return nil
+}
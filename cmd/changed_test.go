@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/specture-system/specture/internal/testhelpers"
+)
+
+// Helper to run the changed command and return the output and error.
+func execChanged(t *testing.T, tmpDir string, flags map[string]string) (string, error) {
+	t.Helper()
+
+	originalWd, _ := os.Getwd()
+	t.Cleanup(func() {
+		os.Chdir(originalWd)
+		changedCmd.Flags().Set("base", "")
+		changedCmd.Flags().Set("head", "HEAD")
+		changedCmd.Flags().Set("format", "text")
+	})
+	os.Chdir(tmpDir)
+
+	out := &bytes.Buffer{}
+	cmd := changedCmd
+	cmd.SetOut(out)
+	cmd.SetErr(out)
+	for name, value := range flags {
+		cmd.Flags().Set(name, value)
+	}
+
+	err := runChanged(cmd, nil)
+	return out.String(), err
+}
+
+func setupChangedTest(t *testing.T) string {
+	t.Helper()
+	tmpDir := setupListTest(t, map[string]string{
+		"001-first/SPEC.md":  "---\nstatus: draft\n---\n\n# First Feature\n",
+		"002-second/SPEC.md": "---\nstatus: approved\n---\n\n# Second Feature\n",
+	})
+	testhelpers.InitGitRepo(t, tmpDir)
+	for _, args := range [][]string{
+		{"add", "-A"},
+		{"commit", "-m", "base"},
+		{"branch", "-M", "main"},
+		{"checkout", "-q", "-b", "feature"},
+	} {
+		if err := testhelpers.RunGitCommand(tmpDir, args); err != nil {
+			t.Fatalf("git %v failed: %v", args, err)
+		}
+	}
+
+	testhelpers.WriteFile(t, tmpDir, "specs/001-first/SPEC.md", "---\nstatus: approved\n---\n\n# First Feature\n")
+	testhelpers.WriteFile(t, tmpDir, "specs/003-third/SPEC.md", "---\nstatus: draft\n---\n\n# Third Feature\n")
+	commitAll(t, tmpDir, "Alice", 0)
+	return tmpDir
+}
+
+func TestChangedCommand_TextOutput(t *testing.T) {
+	tmpDir := setupChangedTest(t)
+
+	output, err := execChanged(t, tmpDir, map[string]string{"base": "main"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "Spec changes between main and HEAD (2):\n\n" +
+		"CHANGE    REF  NAME           STATUS            PATH                   \n" +
+		"modified  1    First Feature  draft → approved  specs/001-first/SPEC.md\n" +
+		"added     3    Third Feature  draft             specs/003-third/SPEC.md\n"
+	if output != want {
+		t.Errorf("unexpected output:\n%s\nwant:\n%s", output, want)
+	}
+
+	output, err = execChanged(t, tmpDir, map[string]string{"base": "main", "head": "main"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output != "No spec changes between main and main\n" {
+		t.Errorf("unexpected output for identical revisions: %q", output)
+	}
+}
+
+func TestChangedCommand_JSONOutput(t *testing.T) {
+	tmpDir := setupChangedTest(t)
+
+	output, err := execChanged(t, tmpDir, map[string]string{"base": "main", "head": "feature", "format": "json"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var result changedJSONOutput
+	if err := json.Unmarshal([]byte(output), &result); err != nil {
+		t.Fatalf("failed to parse JSON: %v\noutput: %s", err, output)
+	}
+	if result.Base != "main" || result.Head != "feature" || len(result.Changes) != 2 {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	first, third := result.Changes[0], result.Changes[1]
+	if first.Kind != "modified" || first.OldStatus != "draft" || first.Status != "approved" || !first.StatusChanged {
+		t.Errorf("unexpected change for spec 1: %+v", first)
+	}
+	if third.Kind != "added" || third.Ref != "3" || third.OldPath != "" || third.StatusChanged || len(third.Files) != 1 {
+		t.Errorf("unexpected change for spec 3: %+v", third)
+	}
+}
+
+func TestChangedCommand_Errors(t *testing.T) {
+	tmpDir := setupChangedTest(t)
+
+	tests := []struct {
+		flags map[string]string
+		want  string
+	}{
+		{map[string]string{}, "--base is required"},
+		{map[string]string{"base": "no-such-branch"}, "unknown git revision: no-such-branch"},
+		{map[string]string{"base": "main", "format": "yaml"}, "invalid format: yaml"},
+	}
+	for _, tt := range tests {
+		_, err := execChanged(t, tmpDir, tt.flags)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%v: expected error containing %q, got %v", tt.flags, tt.want, err)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var configFormatFlag string
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Inspect the project configuration",
+	Long: `Inspect the project configuration stored in .specture.yaml.
+
+The file lives in the project root next to the specs directory. Every setting
+is optional except version; missing settings keep their defaults.
+
+Example .specture.yaml:
+  version: 1
+  statuses: [draft, approved, in-progress, completed, rejected, deferred]
+  priorities: [p0, p1, p2, p3]
+  list:
+    default_statuses: [draft, approved, in-progress]
+  numbering:
+    padding: 3
+  frontmatter:
+    required: [status, author]
+  templates:
+    spec: .specture/templates/spec.md
+    plan: .specture/templates/plan.md
+  validation:
+    rules:
+      numbered-headings: warning
+      supersession: off
+  workflow:
+    initial: [draft]
+    transitions:
+      draft: [approved, rejected]
+      approved: [in-progress, rejected]
+      in-progress: [completed, rejected]
+  labels:
+    allowed: [cli, validation, distribution, docs]`,
+}
+
+var configShowCmd = &cobra.Command{
+	Use:   "show",
+	Args:  cobra.NoArgs,
+	Short: "Print the effective configuration",
+	Long: `Print the effective configuration: the values from .specture.yaml merged
+over the defaults.
+
+Examples:
+  specture config show
+  specture config show -f json`,
+	RunE: runConfigShow,
+}
+
+func init() {
+	configShowCmd.Flags().StringVarP(&configFormatFlag, "format", "f", "yaml", "Output format: yaml or json")
+	configCmd.AddCommand(configShowCmd)
+}
+
+func runConfigShow(cmd *cobra.Command, args []string) error {
+	format, _ := cmd.Flags().GetString("format")
+	if format != "yaml" && format != "json" {
+		return fmt.Errorf("invalid format: %s (must be 'yaml' or 'json')", format)
+	}
+
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	if format == "json" {
+		data, err := json.MarshalIndent(cfg, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		cmd.Println(string(data))
+		return nil
+	}
+
+	data, err := cfg.Marshal()
+	if err != nil {
+		return err
+	}
+	if cfg.Path == "" {
+		cmd.Println("# No .specture.yaml found; showing defaults")
+	} else {
+		cmd.Printf("# Loaded from %s\n", cfg.Path)
+	}
+	cmd.Print(string(data))
+	return nil
+}
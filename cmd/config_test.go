@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func execConfigShow(t *testing.T, dir, format string) (string, error) {
+	t.Helper()
+
+	originalWd, _ := os.Getwd()
+	t.Cleanup(func() {
+		os.Chdir(originalWd)
+		configShowCmd.Flags().Set("format", "yaml")
+	})
+	os.Chdir(dir)
+
+	out := &bytes.Buffer{}
+	configShowCmd.SetOut(out)
+	configShowCmd.SetErr(out)
+	configShowCmd.Flags().Set("format", format)
+
+	err := runConfigShow(configShowCmd, nil)
+	return out.String(), err
+}
+
+func TestConfigShow_Defaults(t *testing.T) {
+	tmpDir := setupListTest(t, nil)
+
+	output, err := execConfigShow(t, tmpDir, "yaml")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, want := range []string{"No .specture.yaml found", "padding: 3", "- in-progress"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected %q in output, got:\n%s", want, output)
+		}
+	}
+}
+
+func TestConfigShow_MergedJSON(t *testing.T) {
+	tmpDir := setupListTest(t, nil)
+	content := "version: 1\nnumbering:\n  padding: 4\nlist:\n  default_statuses: [draft]\n"
+	if err := os.WriteFile(filepath.Join(tmpDir, ".specture.yaml"), []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	output, err := execConfigShow(t, tmpDir, "json")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var result struct {
+		Numbering struct {
+			Padding int `json:"padding"`
+		} `json:"numbering"`
+		Statuses []string `json:"statuses"`
+		List     struct {
+			DefaultStatuses []string `json:"default_statuses"`
+		} `json:"list"`
+	}
+	if err := json.Unmarshal([]byte(output), &result); err != nil {
+		t.Fatalf("failed to parse JSON: %v\noutput: %s", err, output)
+	}
+	if result.Numbering.Padding != 4 || len(result.Statuses) != 5 || len(result.List.DefaultStatuses) != 1 {
+		t.Errorf("expected merged config, got %+v", result)
+	}
+}
+
+func TestListCommand_ConfiguredDefaultStatuses(t *testing.T) {
+	tmpDir := setupListTest(t, map[string]string{
+		"001-draft/SPEC.md":    "---\nstatus: draft\n---\n\n# Draft Spec\n",
+		"002-approved/SPEC.md": "---\nstatus: approved\n---\n\n# Approved Spec\n",
+	})
+	content := "version: 1\nlist:\n  default_statuses: [approved]\n"
+	if err := os.WriteFile(filepath.Join(tmpDir, ".specture.yaml"), []byte(content), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	output, err := execList(t, tmpDir, map[string]string{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(output, "Draft Spec") || !strings.Contains(output, "Approved Spec") {
+		t.Errorf("expected only configured default statuses, got:\n%s", output)
+	}
+}
@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	specpkg "github.com/specture-system/specture/internal/spec"
+	"github.com/spf13/cobra"
+)
+
+var linksFormatFlag string
+
+var linksCmd = &cobra.Command{
+	Use:   "links <ref>",
+	Args:  cobra.ExactArgs(1),
+	Short: "Show links to and from a spec",
+	Long: `Show the markdown links between a spec and other specs.
+
+Outbound links are the specs this spec links to. Inbound links ("backlinks")
+are the specs that link to this spec. Check inbound links before changing or
+rejecting a spec to see what depends on it. Outbound links whose destination
+does not match any spec are listed as not found.
+
+Examples:
+  specture links 4
+  specture links 4.2
+  specture links specs/004-list-command/SPEC.md
+  specture links 4 -f json`,
+	RunE: runLinks,
+}
+
+func init() {
+	linksCmd.Flags().StringVarP(&linksFormatFlag, "format", "f", "text", "Output format: text or json")
+}
+
+func runLinks(cmd *cobra.Command, args []string) error {
+	format, _ := cmd.Flags().GetString("format")
+	if format != "text" && format != "json" {
+		return fmt.Errorf("invalid format: %s (must be 'text' or 'json')", format)
+	}
+
+	specsDir, err := resolveSpecsDir()
+	if err != nil {
+		return err
+	}
+
+	tree, err := specpkg.BuildTree(specsDir)
+	if err != nil {
+		return err
+	}
+
+	node, err := tree.Resolve(args[0])
+	if err != nil {
+		return err
+	}
+
+	outbound := tree.Outbound(node)
+	inbound := tree.Inbound(node)
+
+	if format == "json" {
+		return formatLinksJSON(cmd, node, outbound, inbound)
+	}
+	return formatLinksText(cmd, node, outbound, inbound)
+}
+
+// formatLinksText outputs outbound and inbound links as two aligned tables.
+func formatLinksText(cmd *cobra.Command, node *specpkg.Node, outbound, inbound []specpkg.Reference) error {
+	cmd.Printf("Links for %s: %s\n", node.Spec.FullRef, node.Spec.Name)
+
+	cmd.Printf("\nOutbound (%d):\n", len(outbound))
+	printLinkRows(cmd, outbound, func(ref specpkg.Reference) *specpkg.Node { return ref.To })
+
+	cmd.Printf("\nInbound (%d):\n", len(inbound))
+	printLinkRows(cmd, inbound, func(ref specpkg.Reference) *specpkg.Node { return ref.From })
+
+	return nil
+}
+
+// printLinkRows prints one row per reference, describing the spec on the
+// other end of the link as chosen by other.
+func printLinkRows(cmd *cobra.Command, refs []specpkg.Reference, other func(specpkg.Reference) *specpkg.Node) {
+	if len(refs) == 0 {
+		cmd.Println("  (none)")
+		return
+	}
+
+	refWidth := 0
+	nameWidth := 0
+	for _, ref := range refs {
+		if target := other(ref); target != nil {
+			refWidth = max(refWidth, len(target.Spec.FullRef))
+			nameWidth = max(nameWidth, len(target.Spec.Name))
+		}
+	}
+
+	rowFmt := fmt.Sprintf("  %%-%ds  %%-%ds  %%s\n", refWidth, nameWidth)
+	for _, ref := range refs {
+		target := other(ref)
+		if target == nil {
+			cmd.Printf("  %s (not found)\n", ref.Link.Destination)
+			continue
+		}
+		cmd.Printf(rowFmt, target.Spec.FullRef, target.Spec.Name, target.Spec.Path)
+	}
+}
+
+// linksJSONOutput is the JSON shape of the links command.
+type linksJSONOutput struct {
+	Ref      string           `json:"ref"`
+	Name     string           `json:"name"`
+	Path     string           `json:"path"`
+	Outbound []linkJSONOutput `json:"outbound"`
+	Inbound  []linkJSONOutput `json:"inbound"`
+}
+
+// linkJSONOutput describes the spec on the other end of a link. Ref, name,
+// status, and path are empty for links that do not resolve to a spec.
+type linkJSONOutput struct {
+	Ref         string `json:"ref"`
+	Name        string `json:"name"`
+	Status      string `json:"status"`
+	Path        string `json:"path"`
+	Text        string `json:"text"`
+	Destination string `json:"destination"`
+}
+
+// formatLinksJSON outputs a spec's outbound and inbound links as JSON.
+func formatLinksJSON(cmd *cobra.Command, node *specpkg.Node, outbound, inbound []specpkg.Reference) error {
+	output := linksJSONOutput{
+		Ref:      node.Spec.FullRef,
+		Name:     node.Spec.Name,
+		Path:     node.Spec.Path,
+		Outbound: make([]linkJSONOutput, 0, len(outbound)),
+		Inbound:  make([]linkJSONOutput, 0, len(inbound)),
+	}
+	for _, ref := range outbound {
+		output.Outbound = append(output.Outbound, newLinkJSONOutput(ref.To, ref.Link))
+	}
+	for _, ref := range inbound {
+		output.Inbound = append(output.Inbound, newLinkJSONOutput(ref.From, ref.Link))
+	}
+
+	data, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+
+	cmd.Println(string(data))
+	return nil
+}
+
+func newLinkJSONOutput(other *specpkg.Node, link specpkg.Link) linkJSONOutput {
+	output := linkJSONOutput{
+		Text:        link.Text,
+		Destination: link.Destination,
+	}
+	if other != nil {
+		output.Ref = other.Spec.FullRef
+		output.Name = other.Spec.Name
+		output.Status = other.Spec.Status
+		output.Path = other.Spec.Path
+	}
+	return output
+}
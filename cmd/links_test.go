@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+)
+
+func execLinks(t *testing.T, dir string, args []string, format string) (string, error) {
+	t.Helper()
+	originalWd, _ := os.Getwd()
+	t.Cleanup(func() {
+		os.Chdir(originalWd)
+		linksCmd.Flags().Set("format", "text")
+	})
+	os.Chdir(dir)
+
+	out := &bytes.Buffer{}
+	linksCmd.SetOut(out)
+	linksCmd.SetErr(out)
+	linksCmd.Flags().Set("format", format)
+
+	err := runLinks(linksCmd, args)
+	return out.String(), err
+}
+
+func setupLinksCommandTest(t *testing.T) string {
+	t.Helper()
+	return setupListTest(t, map[string]string{
+		"001-target/SPEC.md": "---\nstatus: approved\n---\n\n# Target\n",
+		"002-linker/SPEC.md": "---\nstatus: draft\n---\n\n# Linker\n\nBuilds on [Target](specs/001-target/SPEC.md) and [Gone](specs/009-gone/SPEC.md).\n",
+	})
+}
+
+func TestLinksCommand_Text(t *testing.T) {
+	dir := setupLinksCommandTest(t)
+
+	output, err := execLinks(t, dir, []string{"2"}, "text")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, want := range []string{"Links for 2: Linker", "Outbound (2):", "1  Target", "specs/009-gone/SPEC.md (not found)", "Inbound (0):", "(none)"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected %q in output:\n%s", want, output)
+		}
+	}
+
+	output, err = execLinks(t, dir, []string{"1"}, "text")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(output, "Inbound (1):") || !strings.Contains(output, "2  Linker") {
+		t.Errorf("expected backlink from spec 2:\n%s", output)
+	}
+
+	// Spec file paths work like refs.
+	byPath, err := execLinks(t, dir, []string{"specs/001-target/SPEC.md"}, "text")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if byPath != output {
+		t.Errorf("expected the same output by path:\n%s\nwant:\n%s", byPath, output)
+	}
+}
+
+func TestLinksCommand_JSON(t *testing.T) {
+	dir := setupLinksCommandTest(t)
+
+	output, err := execLinks(t, dir, []string{"1"}, "json")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var result linksJSONOutput
+	if err := json.Unmarshal([]byte(output), &result); err != nil {
+		t.Fatalf("failed to parse JSON: %v\noutput: %s", err, output)
+	}
+	if result.Ref != "1" || len(result.Outbound) != 0 || len(result.Inbound) != 1 {
+		t.Fatalf("unexpected links output: %+v", result)
+	}
+	inbound := result.Inbound[0]
+	if inbound.Ref != "2" || inbound.Status != "draft" || inbound.Text != "Target" {
+		t.Errorf("unexpected backlink: %+v", inbound)
+	}
+	if !strings.Contains(output, `"outbound": []`) {
+		t.Errorf("expected empty outbound array, got:\n%s", output)
+	}
+}
+
+func TestLinksCommand_Errors(t *testing.T) {
+	dir := setupLinksCommandTest(t)
+
+	if _, err := execLinks(t, dir, []string{"9"}, "text"); err == nil || !strings.Contains(err.Error(), "spec not found") {
+		t.Errorf("expected spec not found error, got %v", err)
+	}
+	if _, err := execLinks(t, dir, []string{"1"}, "xml"); err == nil || !strings.Contains(err.Error(), "invalid format") {
+		t.Errorf("expected invalid format error, got %v", err)
+	}
+}
@@ -1,22 +1,23 @@
 package cmd
 
 import (
-	"encoding/json"
+	"errors"
 	"fmt"
-	"os"
-	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/specture-system/specture/internal/format"
+	"github.com/specture-system/specture/internal/query"
 	specpkg "github.com/specture-system/specture/internal/spec"
 	"github.com/spf13/cobra"
 )
 
-var listStatusFilter string
-var listAssigneeFilter string
 var listFormatFlag string
-var listParentFlag string
-var listDepthFlag string
+var listColumnsFlag string
+var listTemplateFlag string
+var listTemplateFileFlag string
 
 var listCmd = &cobra.Command{
 	Use:     "list",
@@ -25,16 +26,84 @@ var listCmd = &cobra.Command{
 	Long: `List specs with optional filtering, parent scoping, and depth control.
 
 By default, shows a compact table with Ref, Name, Status, and Path for the full spec tree.
-Completed specs are hidden by default since they're noise for daily work.
+Completed specs are hidden by default since they're noise for daily work. The
+statuses shown by default can be changed with list.default_statuses in
+.specture.yaml.
 
 Use --status to filter by one or more statuses. Use --status all to show every
-status. Use --assignee to filter by one or more assignee names; matching is
-case-insensitive and requires the complete name.
+status. Use --assignee to filter by one or more assignee names; a spec matches
+when any of its assignees does. Matching is case-insensitive and requires the
+complete name.
+
+Use --label to filter by labels from the labels frontmatter list. Labels in one
+--label value are alternatives (--label cli,validation matches either); repeat
+--label to require every filter (--label cli --label validation matches both).
+Prefix a label with ! to exclude it (--label '!docs').
+
+Use --milestone to filter by one or more milestones from the milestone
+frontmatter. Matching is case-insensitive and requires the complete milestone.
+
+Use --where for filters the flags above cannot express. An expression compares
+fields with =, !=, <, <=, >, >=, ~ (contains), in (...), or not in (...), and
+combines comparisons with and, or, not, and parentheses:
+
+  status in (draft, approved) and assignee = "Alice" and not label = docs
+  created > 2026-01-01 or (priority = high and progress < 50)
+
+Fields are ref, name (or title), status, assignee, labels (or label),
+priority, milestone, author, created, approved_by, approval_date, path,
+depends_on, blocks, supersedes, superseded_by, has_plan, progress (percent of
+tasks checked), created_at, created_by, updated_at (or updated), and
+updated_by (from git history), and any other frontmatter key. Matching
+ignores case, list fields match when any item does, and fields a spec does
+not set compare as empty. Statuses and priorities compare in their configured
+order, as --sort orders them, so priority < high matches higher priorities.
+Numbers and refs compare numerically, other values as written, which orders
+YYYY-MM-DD dates. When the expression compares status and --status is not
+given, the default status filter is not applied.
+
+Use --updated-since to show specs changed within a period, such as 30d, 2w,
+or 12h, or since a date such as 2026-01-01. Use --stale to show specs not
+changed for at least a period; without --status it only considers approved
+and in-progress specs, the ones that can be neglected. A spec's created and
+updated times come from git: the first commit of its SPEC.md, following
+renames, and the latest commit of its SPEC.md or PLAN.md. Specs that were
+never committed use their file modification time.
+
+Use --ready to show only approved specs whose dependencies are all completed.
+Dependencies come from the depends_on frontmatter of the spec and the blocks
+frontmatter of other specs.
+
+Superseded specs, those replaced by another spec through supersedes or
+superseded_by frontmatter, are hidden by default like completed specs. Use
+--superseded or --status all to include them; they are marked in the output.
 
 Use --parent to scope to the children of a specific parent spec.
 Use --depth to control how deep to recurse into the spec hierarchy (default: all).
-Use --format json for machine-readable output with ref, name, status, assignee,
-and path.
+
+Use --sort to order specs by ref (the default), name, status, assignee,
+priority, created, or updated, and --reverse to flip the order. Statuses sort
+in the order of statuses in .specture.yaml and priorities in the order of
+priorities, highest first. created is the creation_date frontmatter and
+updated is the time of the latest change, as for --stale. Specs without
+a value for the sort key are listed last, and ties keep ref order.
+
+The PROGRESS column shows checked and total task list items ("- [x]" and
+"- [ ]") across SPEC.md and PLAN.md; it appears when any listed spec has tasks.
+The PRIORITY, MILESTONE, ASSIGNEE, and LABELS columns appear when any listed
+spec has a value for them.
+
+Use --format to choose the output: text (the default table), csv, markdown
+(a table for pull requests and READMEs), json, ndjson (one JSON object per
+line, for streaming), or yaml. Structured formats (json, ndjson, yaml) include
+every field: ref, name, status, assignee, labels, priority, milestone, path,
+the remaining frontmatter fields, section headings, plan presence, whether the
+spec is superseded, task progress, and the created_at, created_by, updated_at,
+and updated_by of its git history. Use --columns to choose and order the
+fields for any format, using the JSON field names. Use --format tree to show
+the spec hierarchy instead of a table; see specture tree.
+
+` + templateHelp + `
 
 Examples:
   specture list                          # List all specs recursively (hides completed)
@@ -47,61 +116,196 @@ Examples:
   specture list --status draft,approved  # Multiple statuses
   specture list --assignee Alice         # Filter by assignee
   specture list --assignee Alice,Bob     # Multiple assignees
-  specture list -f json                  # JSON output`,
+  specture list --label cli              # Filter by label
+  specture list -l cli -l '!docs'        # Label cli but not docs
+  specture list --milestone v1.0 --sort priority  # What's next for v1.0
+  specture list --sort updated --reverse # Most recently updated first
+  specture list --updated-since 30d      # Specs changed in the last 30 days
+  specture list --stale 90d              # Approved and in-progress specs untouched for 90 days
+  specture list --ready                  # Approved specs ready to start
+  specture list --where 'label = cli and created > 2026-01-01'
+  specture list --superseded             # Include superseded specs
+  specture list -f json                  # JSON output
+  specture list -f markdown              # Markdown table
+  specture list -f tree                  # Hierarchy with status markers
+  specture list -f csv --columns ref,name,priority,milestone
+  specture list --template '{{.Ref}}\t{{.Status}}'`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return runList(cmd, args)
 	},
 }
 
 func init() {
-	listCmd.Flags().StringVarP(&listStatusFilter, "status", "s", "", `Filter by status (comma-separated for multiple); use "all" for all statuses`)
-	listCmd.Flags().StringVar(&listAssigneeFilter, "assignee", "", "Filter by assignee (comma-separated for multiple, case-insensitive)")
-	listCmd.Flags().StringVarP(&listFormatFlag, "format", "f", "text", "Output format: text or json")
-	listCmd.Flags().StringVarP(&listParentFlag, "parent", "p", "", "Parent spec reference to list children for")
-	listCmd.Flags().StringVarP(&listDepthFlag, "depth", "d", "all", "Recursion depth (1 = immediate scope, 0 or all = unlimited)")
+	addListFlags(listCmd)
+	listCmd.Flags().StringVarP(&listFormatFlag, "format", "f", "text", "Output format: "+strings.Join(listFormatNames(), ", "))
+	addTemplateFlags(listCmd, &listTemplateFlag, &listTemplateFileFlag)
+	listCmd.Flags().StringVar(&listColumnsFlag, "columns", "", "Comma-separated fields to output, in order (for example ref,name,priority)")
+}
+
+// addListFlags adds the scope, filter, and sort flags shared by list and
+// tree. listSpecs, sortSpecs, and runTree read them through cmd.Flags().
+func addListFlags(cmd *cobra.Command) {
+	flags := cmd.Flags()
+	flags.StringP("status", "s", "", `Filter by status (comma-separated for multiple); use "all" for all statuses`)
+	flags.String("assignee", "", "Filter by assignee (comma-separated for multiple, case-insensitive)")
+	flags.StringArrayP("label", "l", nil, `Filter by label: comma-separated labels match any, repeat the flag to require all, prefix with "!" to exclude`)
+	flags.String("milestone", "", "Filter by milestone (comma-separated for multiple, case-insensitive)")
+	flags.String("where", "", `Filter by an expression, such as 'status in (draft, approved) and not label = docs'; see specture list --help`)
+	flags.String("updated-since", "", "Show specs changed within a period (such as 30d, 2w, or 12h) or since a date")
+	flags.String("stale", "", "Show specs not changed for at least a period, such as 90d")
+	flags.StringP("parent", "p", "", "Parent spec reference to scope to")
+	flags.StringP("depth", "d", "all", "Recursion depth (1 = immediate scope, 0 or all = unlimited)")
+	flags.Bool("ready", false, "Show only approved specs whose dependencies are all completed")
+	flags.Bool("superseded", false, "Include specs that have been superseded by another spec")
+	flags.String("sort", "ref", "Sort by "+strings.Join(specpkg.SortKeys, ", ")+"; trees sort siblings")
+	flags.Bool("reverse", false, "Reverse the sort order")
+	flags.Bool("expand", false, "In tree output, show the descendants of completed specs instead of collapsing them")
 }
 
 func runList(cmd *cobra.Command, args []string) error {
-	format, _ := cmd.Flags().GetString("format")
-	if format != "text" && format != "json" {
-		return fmt.Errorf("invalid format: %s (must be 'text' or 'json')", format)
+	formatName, err := resolveFormat(cmd)
+	if err != nil {
+		return err
+	}
+	if formatName == treeFormat {
+		return runTree(cmd, args)
+	}
+	formatter, err := format.Get(formatName)
+	if err != nil && formatName != templateFormat {
+		return fmt.Errorf("invalid format: %s (must be one of: %s)", formatName, strings.Join(listFormatNames(), ", "))
+	}
+
+	columns, _ := cmd.Flags().GetString("columns")
+	history := formatName == templateFormat || showsHistory(columns, formatter)
+	tree, _, specs, err := listSpecs(cmd, history)
+	if err != nil {
+		return err
+	}
+
+	if err := sortSpecs(cmd, specs); err != nil {
+		return err
+	}
+
+	if formatName == templateFormat {
+		t, err := loadOutputTemplate(cmd, tree)
+		if err != nil {
+			return err
+		}
+		nodes := make([]*specpkg.Node, len(specs))
+		for i, spec := range specs {
+			nodes[i] = tree.NodeForSpec(spec)
+		}
+		return writeTemplate(cmd, t, tree, nodes)
+	}
+
+	fields, err := selectListFields(listFields(tree), columns, formatter)
+	if err != nil {
+		return err
 	}
+	return writeList(cmd, formatter, fields, specs)
+}
+
+// listFormatNames returns the formats list accepts: the registered
+// formatters, tree, and template.
+func listFormatNames() []string {
+	return append(format.Names(), treeFormat, templateFormat)
+}
 
-	cwd, err := os.Getwd()
+// listSpecs builds the spec tree and applies the scope and filter flags
+// shared by list and tree. It returns the tree, the --parent spec (nil when
+// the scope is the whole tree), and the matching specs in ref order. Git
+// history is loaded when history is set or the flags need it.
+func listSpecs(cmd *cobra.Command, history bool) (*specpkg.Tree, *specpkg.Node, []*specpkg.SpecInfo, error) {
+	specsDir, err := resolveSpecsDir()
 	if err != nil {
-		return fmt.Errorf("failed to get current directory: %w", err)
+		return nil, nil, nil, err
 	}
 
-	specsDir := filepath.Join(cwd, "specs")
+	tree, err := specpkg.BuildTree(specsDir)
+	if err != nil {
+		return nil, nil, nil, err
+	}
 
 	parentRef, _ := cmd.Flags().GetString("parent")
+	var parent *specpkg.Node
 	var parentPath string
 	if strings.TrimSpace(parentRef) != "" {
-		parentPath, err = specpkg.ResolvePath(specsDir, parentRef)
+		parent, err = tree.Resolve(parentRef)
 		if err != nil {
-			return err
+			return nil, nil, nil, err
 		}
+		parentPath = parent.FilePath
 	}
 
-	depth, err := parseDepth()
+	depth, err := parseDepth(cmd)
 	if err != nil {
-		return err
+		return nil, nil, nil, err
 	}
 
-	specs, err := specpkg.FindSpecsInScopeDepth(specsDir, parentPath, depth)
+	nodes, err := tree.ScopeDepth(parentPath, depth)
 	if err != nil {
-		return err
+		return nil, nil, nil, err
+	}
+	specs := make([]*specpkg.SpecInfo, 0, len(nodes))
+	for _, node := range nodes {
+		specs = append(specs, node.Spec)
+	}
+
+	var where query.Expr
+	if raw, _ := cmd.Flags().GetString("where"); strings.TrimSpace(raw) != "" {
+		where, err = parseWhere(raw)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	updatedSince, err := parseTimeFlag(cmd, "updated-since")
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	staleBefore, err := parseTimeFlag(cmd, "stale")
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	// Reading git history runs git log over the whole specs directory, so it
+	// is skipped unless the output, filters, or sort order use it.
+	sortKey, _ := cmd.Flags().GetString("sort")
+	if history || !updatedSince.IsZero() || !staleBefore.IsZero() || sortKey == "updated" || referencesHistory(where) {
+		if err := tree.LoadHistory(); err != nil {
+			return nil, nil, nil, err
+		}
 	}
 
-	// Apply status filter
 	statusFilter, _ := cmd.Flags().GetString("status")
-	if statusFilter == "all" {
-		// --status all shows every status including completed
-	} else if statusFilter != "" {
-		specs = filterByStatus(specs, statusFilter)
+	includeSuperseded, _ := cmd.Flags().GetBool("superseded")
+	whereStatus := where != nil && where.References("status")
+	if statusFilter == "" && !staleBefore.IsZero() && !whereStatus {
+		// Only active specs can be neglected.
+		statusFilter = staleStatuses
+	}
+	if statusFilter == "" && whereStatus {
+		// The expression picks statuses itself; the default status filter
+		// would only hide its matches.
+		if !includeSuperseded {
+			specs = filterSuperseded(tree, specs)
+		}
 	} else {
-		// Default: hide completed specs; they're noise for daily work.
-		specs = filterByStatus(specs, "draft,approved,in-progress")
+		specs, err = filterStatuses(tree, specs, statusFilter, includeSuperseded)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	if where != nil {
+		specs, err = filterByWhere(specs, where)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	if !updatedSince.IsZero() || !staleBefore.IsZero() {
+		specs = filterByUpdated(specs, updatedSince, staleBefore)
 	}
 
 	assigneeFilter, _ := cmd.Flags().GetString("assignee")
@@ -109,16 +313,78 @@ func runList(cmd *cobra.Command, args []string) error {
 		specs = filterByAssignee(specs, assigneeFilter)
 	}
 
-	if format == "json" {
-		return formatListJSON(cmd, specs)
+	labelFilters, _ := cmd.Flags().GetStringArray("label")
+	if len(labelFilters) > 0 {
+		specs = filterByLabels(specs, labelFilters)
 	}
-	return formatListText(cmd, specs)
+
+	milestoneFilter, _ := cmd.Flags().GetString("milestone")
+	if milestoneFilter != "" {
+		specs = filterByMilestone(specs, milestoneFilter)
+	}
+
+	ready, _ := cmd.Flags().GetBool("ready")
+	if ready {
+		specs = filterReady(tree, specs)
+	}
+
+	return tree, parent, specs, nil
+}
+
+// staleStatuses are the statuses --stale considers when --status is not
+// given.
+const staleStatuses = "approved,in-progress"
+
+// ageUnits are the units parseTimeFlag accepts beyond Go durations.
+var ageUnits = map[string]time.Duration{
+	"d": 24 * time.Hour,
+	"w": 7 * 24 * time.Hour,
+}
+
+// parseTimeFlag converts a period flag such as --stale 90d into the time
+// that long ago. The value may be a number of days (30d) or weeks (2w), a Go
+// duration (12h), or a YYYY-MM-DD date. An empty flag gives the zero time.
+func parseTimeFlag(cmd *cobra.Command, name string) (time.Time, error) {
+	raw, _ := cmd.Flags().GetString(name)
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return time.Time{}, nil
+	}
+	if date, err := time.ParseInLocation(time.DateOnly, raw, time.Local); err == nil {
+		return date, nil
+	}
+	if unit, ok := ageUnits[raw[len(raw)-1:]]; ok {
+		if n, err := strconv.Atoi(raw[:len(raw)-1]); err == nil && n >= 0 {
+			return time.Now().Add(-time.Duration(n) * unit), nil
+		}
+	}
+	if d, err := time.ParseDuration(raw); err == nil && d >= 0 {
+		return time.Now().Add(-d), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid --%s value: %s (use a period such as 30d, 2w, or 12h, or a date such as 2026-01-01)", name, raw)
+}
+
+// filterByUpdated keeps specs last changed at or after since and before
+// staleBefore, ignoring zero bounds. Specs with no known change time never
+// match.
+func filterByUpdated(specs []*specpkg.SpecInfo, since, staleBefore time.Time) []*specpkg.SpecInfo {
+	var result []*specpkg.SpecInfo
+	for _, spec := range specs {
+		updated := spec.LastUpdated()
+		if updated.IsZero() ||
+			(!since.IsZero() && updated.Before(since)) ||
+			(!staleBefore.IsZero() && !updated.Before(staleBefore)) {
+			continue
+		}
+		result = append(result, spec)
+	}
+	return result
 }
 
 // parseDepth converts the --depth flag string to an int.
 // "all" and "0" mean unlimited.
-func parseDepth() (int, error) {
-	raw := listDepthFlag
+func parseDepth(cmd *cobra.Command) (int, error) {
+	raw, _ := cmd.Flags().GetString("depth")
 
 	switch strings.ToLower(strings.TrimSpace(raw)) {
 	case "all", "0":
@@ -132,6 +398,31 @@ func parseDepth() (int, error) {
 	}
 }
 
+// filterStatuses applies the --status and --superseded filters shared by
+// list and search. An empty status filter keeps the configured default
+// statuses, and superseded specs are dropped unless includeSuperseded is set
+// or the filter is "all".
+func filterStatuses(tree *specpkg.Tree, specs []*specpkg.SpecInfo, statusFilter string, includeSuperseded bool) ([]*specpkg.SpecInfo, error) {
+	if statusFilter == "all" {
+		// --status all shows every status including completed
+	} else if statusFilter != "" {
+		specs = filterByStatus(specs, statusFilter)
+	} else {
+		// Default: hide completed specs; they're noise for daily work.
+		cfg, err := loadConfig()
+		if err != nil {
+			return nil, err
+		}
+		specs = filterByStatus(specs, strings.Join(cfg.List.DefaultStatuses, ","))
+	}
+
+	// Superseded specs are hidden like completed ones unless asked for.
+	if !includeSuperseded && statusFilter != "all" {
+		specs = filterSuperseded(tree, specs)
+	}
+	return specs, nil
+}
+
 // filterByStatus filters specs by one or more comma-separated status values.
 func filterByStatus(specs []*specpkg.SpecInfo, filter string) []*specpkg.SpecInfo {
 	statuses := make(map[string]bool)
@@ -151,7 +442,8 @@ func filterByStatus(specs []*specpkg.SpecInfo, filter string) []*specpkg.SpecInf
 	return filtered
 }
 
-// filterByAssignee filters specs by one or more comma-separated assignee names.
+// filterByAssignee keeps specs assigned to any of one or more comma-separated
+// assignee names.
 func filterByAssignee(specs []*specpkg.SpecInfo, filter string) []*specpkg.SpecInfo {
 	assignees := make([]string, 0)
 	for _, name := range strings.Split(filter, ",") {
@@ -162,8 +454,89 @@ func filterByAssignee(specs []*specpkg.SpecInfo, filter string) []*specpkg.SpecI
 
 	var filtered []*specpkg.SpecInfo
 	for _, spec := range specs {
-		for _, assignee := range assignees {
-			if strings.EqualFold(spec.Assignee, assignee) {
+		if slices.ContainsFunc(spec.Assignees, func(name string) bool {
+			return slices.ContainsFunc(assignees, func(assignee string) bool {
+				return strings.EqualFold(name, assignee)
+			})
+		}) {
+			filtered = append(filtered, spec)
+		}
+	}
+	return filtered
+}
+
+// filterByLabels keeps specs that satisfy every label filter. Each filter is
+// a comma-separated list of labels of which the spec must have at least one;
+// a label prefixed with "!" is satisfied when the spec does not have it.
+// Labels match case-insensitively.
+func filterByLabels(specs []*specpkg.SpecInfo, filters []string) []*specpkg.SpecInfo {
+	var filtered []*specpkg.SpecInfo
+	for _, spec := range specs {
+		if slices.ContainsFunc(filters, func(filter string) bool { return !matchesLabelFilter(spec, filter) }) {
+			continue
+		}
+		filtered = append(filtered, spec)
+	}
+	return filtered
+}
+
+// matchesLabelFilter reports whether spec satisfies any label of a single
+// comma-separated filter. An empty filter matches every spec.
+func matchesLabelFilter(spec *specpkg.SpecInfo, filter string) bool {
+	matched, empty := false, true
+	for _, term := range strings.Split(filter, ",") {
+		term = strings.TrimSpace(term)
+		negated := strings.HasPrefix(term, "!")
+		label := strings.TrimSpace(strings.TrimPrefix(term, "!"))
+		if label == "" {
+			continue
+		}
+		empty = false
+		has := slices.ContainsFunc(spec.Labels, func(l string) bool { return strings.EqualFold(l, label) })
+		if has != negated {
+			matched = true
+		}
+	}
+	return matched || empty
+}
+
+// parseWhere parses a --where expression, pointing at the offending token
+// when it is invalid.
+func parseWhere(input string) (query.Expr, error) {
+	expr, err := query.Parse(input)
+	var parseErr *query.ParseError
+	if errors.As(err, &parseErr) {
+		pointer := strings.ReplaceAll(parseErr.Pointer(input), "\n", "\n  ")
+		return nil, fmt.Errorf("invalid --where expression: %w\n  %s", err, pointer)
+	}
+	return expr, err
+}
+
+// filterByWhere keeps specs matching a --where expression, ranking
+// statuses and priorities in their configured order as sortSpecs does.
+func filterByWhere(specs []*specpkg.SpecInfo, where query.Expr) ([]*specpkg.SpecInfo, error) {
+	cfg, err := loadConfig()
+	if err != nil {
+		return nil, err
+	}
+	orders := query.Orders{Statuses: cfg.Statuses, Priorities: cfg.Priorities}
+
+	var filtered []*specpkg.SpecInfo
+	for _, spec := range specs {
+		if where.Match(query.SpecFields(spec, orders)) {
+			filtered = append(filtered, spec)
+		}
+	}
+	return filtered, nil
+}
+
+// filterByMilestone keeps specs whose milestone is any of one or more
+// comma-separated milestones, ignoring case.
+func filterByMilestone(specs []*specpkg.SpecInfo, filter string) []*specpkg.SpecInfo {
+	var filtered []*specpkg.SpecInfo
+	for _, spec := range specs {
+		for _, milestone := range strings.Split(filter, ",") {
+			if milestone = strings.TrimSpace(milestone); milestone != "" && strings.EqualFold(spec.Milestone, milestone) {
 				filtered = append(filtered, spec)
 				break
 			}
@@ -172,88 +545,259 @@ func filterByAssignee(specs []*specpkg.SpecInfo, filter string) []*specpkg.SpecI
 	return filtered
 }
 
-// formatListText outputs specs as a human-readable table with aligned columns.
-func formatListText(cmd *cobra.Command, specs []*specpkg.SpecInfo) error {
-	if len(specs) == 0 {
-		cmd.Println("No specs found")
+// sortSpecs orders specs by the --sort and --reverse flags, ranking statuses
+// and priorities in their configured order.
+func sortSpecs(cmd *cobra.Command, specs []*specpkg.SpecInfo) error {
+	key, _ := cmd.Flags().GetString("sort")
+	reverse, _ := cmd.Flags().GetBool("reverse")
+	if key == "ref" && !reverse {
+		// Specs are already in ref order.
 		return nil
 	}
 
-	// Calculate column widths from data.
-	refWidth := len("REF")
-	statusWidth := len("STATUS")
-	nameWidth := len("NAME")
-	pathWidth := len("PATH")
-	assigneeWidth := len("ASSIGNEE")
-	showAssignee := false
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	return specpkg.SortSpecs(specs, specpkg.SortOptions{
+		Key:        key,
+		Reverse:    reverse,
+		Statuses:   cfg.Statuses,
+		Priorities: cfg.Priorities,
+	})
+}
+
+// filterReady keeps approved specs whose dependencies are all completed.
+// Dependencies are resolved against the whole tree, so a dependency outside
+// the listed scope still counts.
+func filterReady(tree *specpkg.Tree, specs []*specpkg.SpecInfo) []*specpkg.SpecInfo {
+	var filtered []*specpkg.SpecInfo
 	for _, spec := range specs {
-		if len(spec.FullRef) > refWidth {
-			refWidth = len(spec.FullRef)
-		}
-		if len(spec.Status) > statusWidth {
-			statusWidth = len(spec.Status)
+		if node := tree.NodeForSpec(spec); node != nil && tree.IsReady(node) {
+			filtered = append(filtered, spec)
 		}
-		if len(spec.Name) > nameWidth {
-			nameWidth = len(spec.Name)
+	}
+	return filtered
+}
+
+// filterSuperseded drops specs that another spec in the tree supersedes.
+func filterSuperseded(tree *specpkg.Tree, specs []*specpkg.SpecInfo) []*specpkg.SpecInfo {
+	var filtered []*specpkg.SpecInfo
+	for _, spec := range specs {
+		if !isSuperseded(tree, spec) {
+			filtered = append(filtered, spec)
 		}
-		if len(spec.Path) > pathWidth {
-			pathWidth = len(spec.Path)
+	}
+	return filtered
+}
+
+// isSuperseded reports whether spec is superseded within tree.
+func isSuperseded(tree *specpkg.Tree, spec *specpkg.SpecInfo) bool {
+	node := tree.NodeForSpec(spec)
+	return node != nil && tree.IsSuperseded(node)
+}
+
+// displayStatus returns the status shown in the text table, marking
+// superseded specs.
+func displayStatus(tree *specpkg.Tree, spec *specpkg.SpecInfo) string {
+	if isSuperseded(tree, spec) {
+		return spec.Status + " (superseded)"
+	}
+	return spec.Status
+}
+
+// listField is a field of list output. Its name is the key in structured
+// output and the name accepted by --columns.
+type listField struct {
+	name string
+	// optional fields are hidden from the default table when no listed spec
+	// has a value for them.
+	optional bool
+	cell     func(spec *specpkg.SpecInfo) format.Cell
+}
+
+// listTableColumns are the fields shown by text, CSV, and Markdown output
+// when --columns is not given. Structured formats show every field.
+var listTableColumns = []string{"ref", "name", "status", "priority", "milestone", "progress", "assignee", "labels", "path"}
+
+// historyFields are the list fields and --where fields that come from git
+// history.
+var historyFields = []string{"created_at", "created_by", "updated_at", "updated_by"}
+
+// showsHistory reports whether the --columns value selects a history field,
+// which structured formats do by default.
+func showsHistory(columns string, formatter format.Formatter) bool {
+	if strings.TrimSpace(columns) == "" {
+		return formatter.Structured
+	}
+	for _, name := range strings.Split(columns, ",") {
+		if slices.Contains(historyFields, strings.ToLower(strings.TrimSpace(name))) {
+			return true
 		}
-		if spec.Assignee != "" {
-			showAssignee = true
-			if len(spec.Assignee) > assigneeWidth {
-				assigneeWidth = len(spec.Assignee)
+	}
+	return false
+}
+
+// referencesHistory reports whether a --where expression compares a history
+// field.
+func referencesHistory(where query.Expr) bool {
+	if where == nil {
+		return false
+	}
+	return slices.ContainsFunc(historyFields, where.References)
+}
+
+// listFields returns every list output field in the order of the JSON
+// schema.
+func listFields(tree *specpkg.Tree) []listField {
+	value := func(get func(spec *specpkg.SpecInfo) any) func(*specpkg.SpecInfo) format.Cell {
+		return func(spec *specpkg.SpecInfo) format.Cell { return format.Value(get(spec)) }
+	}
+	return []listField{
+		{name: "ref", cell: value(func(spec *specpkg.SpecInfo) any { return spec.FullRef })},
+		{name: "name", cell: value(func(spec *specpkg.SpecInfo) any { return spec.Name })},
+		{name: "status", cell: func(spec *specpkg.SpecInfo) format.Cell {
+			return format.Cell{Data: spec.Status, Text: displayStatus(tree, spec)}
+		}},
+		{name: "assignee", optional: true, cell: value(func(spec *specpkg.SpecInfo) any { return spec.AssigneeList() })},
+		{name: "assignees", cell: value(func(spec *specpkg.SpecInfo) any { return nonNilStrings(spec.Assignees) })},
+		{name: "labels", optional: true, cell: value(func(spec *specpkg.SpecInfo) any { return nonNilStrings(spec.Labels) })},
+		{name: "priority", optional: true, cell: value(func(spec *specpkg.SpecInfo) any { return spec.Priority })},
+		{name: "milestone", optional: true, cell: value(func(spec *specpkg.SpecInfo) any { return spec.Milestone })},
+		{name: "path", cell: value(func(spec *specpkg.SpecInfo) any { return spec.Path })},
+		{name: "author", cell: value(func(spec *specpkg.SpecInfo) any { return spec.Author })},
+		{name: "creation_date", cell: value(func(spec *specpkg.SpecInfo) any { return spec.CreationDate })},
+		{name: "approved_by", cell: value(func(spec *specpkg.SpecInfo) any { return spec.ApprovedBy })},
+		{name: "approval_date", cell: value(func(spec *specpkg.SpecInfo) any { return spec.ApprovalDate })},
+		{name: "extra", cell: func(spec *specpkg.SpecInfo) format.Cell {
+			extra := spec.Extra
+			if extra == nil {
+				extra = map[string]any{}
 			}
+			return format.Value(extra)
+		}},
+		{name: "sections", cell: func(spec *specpkg.SpecInfo) format.Cell {
+			sections := spec.Sections
+			if sections == nil {
+				sections = []specpkg.Section{}
+			}
+			titles := make([]string, len(sections))
+			for i, section := range sections {
+				titles[i] = section.Title
+			}
+			return format.Cell{Data: sections, Text: strings.Join(titles, ", ")}
+		}},
+		{name: "has_plan", cell: value(func(spec *specpkg.SpecInfo) any { return spec.HasPlan })},
+		{name: "depends_on", cell: value(func(spec *specpkg.SpecInfo) any { return nonNilStrings(spec.DependsOn) })},
+		{name: "blocks", cell: value(func(spec *specpkg.SpecInfo) any { return nonNilStrings(spec.Blocks) })},
+		{name: "supersedes", cell: value(func(spec *specpkg.SpecInfo) any { return nonNilStrings(spec.Supersedes) })},
+		{name: "superseded_by", cell: value(func(spec *specpkg.SpecInfo) any { return nonNilStrings(spec.SupersededBy) })},
+		{name: "superseded", cell: value(func(spec *specpkg.SpecInfo) any { return isSuperseded(tree, spec) })},
+		{name: "progress", optional: true, cell: func(spec *specpkg.SpecInfo) format.Cell {
+			return format.Cell{Data: spec.TotalProgress(), Text: progressCell(spec)}
+		}},
+		{name: "created_at", cell: func(spec *specpkg.SpecInfo) format.Cell { return timestampCell(spec.CreatedAt) }},
+		{name: "created_by", cell: value(func(spec *specpkg.SpecInfo) any { return spec.CreatedBy })},
+		{name: "updated_at", cell: func(spec *specpkg.SpecInfo) format.Cell { return timestampCell(spec.UpdatedAt) }},
+		{name: "updated_by", cell: value(func(spec *specpkg.SpecInfo) any { return spec.UpdatedBy })},
+	}
+}
+
+// selectListFields picks the fields named by a comma-separated --columns
+// value, or the default fields for the formatter when columns is empty.
+// Fields named explicitly are never hidden.
+func selectListFields(fields []listField, columns string, formatter format.Formatter) ([]listField, error) {
+	if strings.TrimSpace(columns) == "" {
+		if formatter.Structured {
+			return fields, nil
+		}
+		columns = strings.Join(listTableColumns, ",")
+	} else {
+		for i := range fields {
+			fields[i].optional = false
 		}
 	}
 
-	if showAssignee {
-		rowFmt := fmt.Sprintf("%%-%ds  %%-%ds  %%-%ds  %%-%ds  %%-%ds\n", refWidth, nameWidth, statusWidth, assigneeWidth, pathWidth)
-		cmd.Printf(rowFmt, "REF", "NAME", "STATUS", "ASSIGNEE", "PATH")
-		for _, spec := range specs {
-			cmd.Printf(rowFmt, spec.FullRef, spec.Name, spec.Status, spec.Assignee, spec.Path)
+	byName := make(map[string]listField, len(fields))
+	names := make([]string, 0, len(fields))
+	for _, field := range fields {
+		byName[field.name] = field
+		names = append(names, field.name)
+	}
+
+	var selected []listField
+	for _, name := range strings.Split(columns, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
 		}
-		return nil
+		field, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown column %q (available: %s)", name, strings.Join(names, ", "))
+		}
+		selected = append(selected, field)
 	}
+	return selected, nil
+}
 
-	rowFmt := fmt.Sprintf("%%-%ds  %%-%ds  %%-%ds  %%-%ds\n", refWidth, nameWidth, statusWidth, pathWidth)
-	cmd.Printf(rowFmt, "REF", "NAME", "STATUS", "PATH")
+// writeList prints specs with the formatter, one record per spec.
+func writeList(cmd *cobra.Command, formatter format.Formatter, fields []listField, specs []*specpkg.SpecInfo) error {
+	if formatter.Name == "text" && len(specs) == 0 {
+		cmd.Println("No specs found")
+		return nil
+	}
 
+	table := format.Table{Columns: make([]format.Column, len(fields))}
+	for i, field := range fields {
+		table.Columns[i] = format.Column{
+			Name:     field.name,
+			Header:   strings.ToUpper(strings.ReplaceAll(field.name, "_", " ")),
+			Optional: field.optional,
+		}
+	}
 	for _, spec := range specs {
-		cmd.Printf(rowFmt, spec.FullRef, spec.Name, spec.Status, spec.Path)
+		row := make([]format.Cell, len(fields))
+		for i, field := range fields {
+			row[i] = field.cell(spec)
+		}
+		table.Rows = append(table.Rows, row)
 	}
-
-	return nil
+	return formatter.Write(cmd.OutOrStdout(), table)
 }
 
-// listJSONOutput represents a single spec in the JSON array output.
-type listJSONOutput struct {
-	Ref      string `json:"ref"`
-	Name     string `json:"name"`
-	Status   string `json:"status"`
-	Assignee string `json:"assignee"`
-	Path     string `json:"path"`
+// progressCell formats a spec's combined task progress for the PROGRESS
+// column, leaving it blank for specs without tasks.
+func progressCell(spec *specpkg.SpecInfo) string {
+	progress := spec.TotalProgress()
+	if progress.Total == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%s (%d%%)", progress, progress.Percent())
 }
 
-// formatListJSON outputs specs as a JSON array with full metadata.
-func formatListJSON(cmd *cobra.Command, specs []*specpkg.SpecInfo) error {
-	output := make([]listJSONOutput, 0, len(specs))
-
-	for _, spec := range specs {
-		output = append(output, listJSONOutput{
-			Ref:      spec.FullRef,
-			Name:     spec.Name,
-			Status:   spec.Status,
-			Assignee: spec.Assignee,
-			Path:     spec.Path,
-		})
+// formatTimestamp formats a git timestamp as RFC 3339 in UTC, or "" when it
+// is unknown.
+func formatTimestamp(t time.Time) string {
+	if t.IsZero() {
+		return ""
 	}
+	return t.UTC().Format(time.RFC3339)
+}
 
-	data, err := json.MarshalIndent(output, "", "  ")
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON: %w", err)
+// timestampCell shows a git timestamp as a date in tables and in full in
+// structured formats.
+func timestampCell(t time.Time) format.Cell {
+	if t.IsZero() {
+		return format.Cell{Data: "", Text: ""}
 	}
+	return format.Cell{Data: formatTimestamp(t), Text: t.Local().Format(time.DateOnly)}
+}
 
-	cmd.Println(string(data))
-	return nil
+// nonNilStrings returns values, or an empty slice when values is nil, so JSON
+// output always encodes lists as arrays.
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
 }
@@ -7,6 +7,12 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/specture-system/specture/internal/format"
+	"github.com/specture-system/specture/internal/testhelpers"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 )
 
 // Helper to create a temp directory with a specs subdirectory and spec files.
@@ -31,6 +37,19 @@ func setupListTest(t *testing.T, specs map[string]string) string {
 	return tmpDir
 }
 
+// resetFlags restores every flag of cmd to its default value and clears its
+// Changed state, so flags set by one test do not leak into the next.
+func resetFlags(cmd *cobra.Command) {
+	cmd.Flags().VisitAll(func(f *pflag.Flag) {
+		if slice, ok := f.Value.(pflag.SliceValue); ok {
+			slice.Replace(nil)
+		} else {
+			f.Value.Set(f.DefValue)
+		}
+		f.Changed = false
+	})
+}
+
 // Helper to run the list command and return the output and error.
 func execList(t *testing.T, tmpDir string, flags map[string]string) (string, error) {
 	t.Helper()
@@ -38,18 +57,7 @@ func execList(t *testing.T, tmpDir string, flags map[string]string) (string, err
 	originalWd, _ := os.Getwd()
 	t.Cleanup(func() {
 		os.Chdir(originalWd)
-		listCmd.Flags().Set("status", "")
-		listCmd.Flags().Set("assignee", "")
-		listCmd.Flags().Set("format", "text")
-		listCmd.Flags().Set("parent", "")
-		// Reset the package variable directly instead of calling Set() so
-		// that the pflag Changed flag isn't marked true. A leaked Changed
-		// from cleanup would corrupt subsequent tests that check whether
-		// --depth was explicitly set.
-		listDepthFlag = "all"
-		if f := listCmd.Flags().Lookup("depth"); f != nil {
-			f.Changed = false
-		}
+		resetFlags(listCmd)
 	})
 	os.Chdir(tmpDir)
 
@@ -851,12 +859,70 @@ func TestListCommand_JSONOutput_AlwaysIncludesAssignee(t *testing.T) {
 		t.Errorf("expected unassigned spec assignee to be an empty string, got %v (present: %t)", got, ok)
 	}
 	for i, entry := range result {
-		if len(entry) != 5 {
-			t.Errorf("entry %d: expected stable five-field schema, got %v", i, entry)
+		if len(entry) != 26 {
+			t.Errorf("entry %d: expected stable twenty-six-field schema, got %v", i, entry)
 		}
 	}
 }
 
+func TestListCommand_JSONOutput_IncludesFrontmatterAndSections(t *testing.T) {
+	tmpDir := setupListTest(t, map[string]string{
+		"001-full/SPEC.md": `---
+status: approved
+author: Alice Example
+creation_date: 2026-01-02
+approved_by: Bob Builder
+approval_date: 2026-01-03
+area: cli
+---
+
+# Full Spec
+
+## Goals
+
+### Stretch Goals
+
+## Design Decisions
+`,
+		"001-full/PLAN.md": "# Full Spec Plan\n",
+	})
+
+	output, err := execList(t, tmpDir, map[string]string{"format": "json"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var result []struct {
+		Author       string           `json:"author"`
+		CreationDate string           `json:"creation_date"`
+		ApprovedBy   string           `json:"approved_by"`
+		ApprovalDate string           `json:"approval_date"`
+		Extra        map[string]any   `json:"extra"`
+		Sections     []map[string]any `json:"sections"`
+		HasPlan      bool             `json:"has_plan"`
+	}
+	if err := json.Unmarshal([]byte(output), &result); err != nil {
+		t.Fatalf("failed to parse JSON: %v\noutput: %s", err, output)
+	}
+	if len(result) != 1 {
+		t.Fatalf("expected 1 spec, got %d", len(result))
+	}
+
+	got := result[0]
+	if got.Author != "Alice Example" || got.CreationDate != "2026-01-02" || got.ApprovedBy != "Bob Builder" || got.ApprovalDate != "2026-01-03" {
+		t.Errorf("unexpected frontmatter fields: %+v", got)
+	}
+	if got.Extra["area"] != "cli" {
+		t.Errorf("expected extra frontmatter key area=cli, got %v", got.Extra)
+	}
+	if len(got.Sections) != 3 || got.Sections[1]["title"] != "Stretch Goals" || got.Sections[1]["level"] != float64(3) {
+		t.Errorf("unexpected sections: %v", got.Sections)
+	}
+	if !got.HasPlan {
+		t.Error("expected has_plan to be true")
+	}
+}
+
 // ---- Filter tests ----
 
 func TestListCommand_FilterSingleStatus(t *testing.T) {
@@ -936,6 +1002,41 @@ func TestListCommand_FilterAssignees(t *testing.T) {
 	}
 }
 
+func TestListCommand_MultipleAssignees(t *testing.T) {
+	tmpDir := setupListTest(t, map[string]string{
+		"001-pair/SPEC.md": "---\nstatus: approved\nassignee: [Alice Example, Bob Builder]\n---\n\n# Pair Feature\n",
+		"002-solo/SPEC.md": "---\nstatus: approved\nassignee: Carol Chief\n---\n\n# Solo Feature\n",
+	})
+
+	output, err := execList(t, tmpDir, map[string]string{"assignee": "bob builder"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	if len(lines) != 2 || !strings.Contains(lines[1], "Pair Feature") {
+		t.Fatalf("expected only the pair spec to match a second assignee:\n%s", output)
+	}
+	if !strings.Contains(lines[1], "Alice Example, Bob Builder") {
+		t.Errorf("expected comma-joined assignees, got %q", lines[1])
+	}
+
+	output, err = execList(t, tmpDir, map[string]string{"assignee": "", "format": "json"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var result []map[string]any
+	if err := json.Unmarshal([]byte(output), &result); err != nil {
+		t.Fatalf("failed to parse JSON: %v\noutput: %s", err, output)
+	}
+	if result[0]["assignee"] != "Alice Example, Bob Builder" {
+		t.Errorf("expected joined assignee string, got %v", result[0]["assignee"])
+	}
+	assignees, ok := result[0]["assignees"].([]any)
+	if !ok || len(assignees) != 2 || assignees[1] != "Bob Builder" {
+		t.Errorf("expected assignees array, got %v", result[0]["assignees"])
+	}
+}
+
 func TestListCommand_FilterNoMatches(t *testing.T) {
 	tmpDir := setupListTest(t, map[string]string{
 		"001-setup/SPEC.md": listCompletedSpec,
@@ -1081,3 +1182,516 @@ func TestListCommand_FilterStatusAll_JSON(t *testing.T) {
 		t.Fatalf("expected 3 specs in --status all JSON, got %d", len(result))
 	}
 }
+
+func TestListCommand_Ready(t *testing.T) {
+	tmpDir := setupListTest(t, map[string]string{
+		"001-done/SPEC.md":        "---\nstatus: completed\n---\n\n# Done\n",
+		"002-pending/SPEC.md":     "---\nstatus: in-progress\n---\n\n# Pending\n",
+		"003-ready/SPEC.md":       "---\nstatus: approved\ndepends_on: [1]\n---\n\n# Ready\n",
+		"004-waiting/SPEC.md":     "---\nstatus: approved\ndepends_on: [1, 2]\n---\n\n# Waiting\n",
+		"005-blocked/SPEC.md":     "---\nstatus: approved\n---\n\n# Blocked\n",
+		"006-blocker/SPEC.md":     "---\nstatus: draft\nblocks: 5\n---\n\n# Blocker\n",
+		"007-unresolved/SPEC.md":  "---\nstatus: approved\ndepends_on: 99\n---\n\n# Unresolved\n",
+		"008-independent/SPEC.md": "---\nstatus: approved\n---\n\n# Independent\n",
+		"009-draft/SPEC.md":       "---\nstatus: draft\n---\n\n# Draft\n",
+	})
+
+	output, err := execList(t, tmpDir, map[string]string{"ready": "true", "format": "json"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var result []map[string]any
+	if err := json.Unmarshal([]byte(output), &result); err != nil {
+		t.Fatalf("failed to parse JSON: %v\noutput: %s", err, output)
+	}
+	var refs []string
+	for _, entry := range result {
+		refs = append(refs, entry["ref"].(string))
+	}
+	if strings.Join(refs, ",") != "3,8" {
+		t.Fatalf("expected ready specs 3 and 8, got %v", refs)
+	}
+	if deps, ok := result[0]["depends_on"].([]any); !ok || len(deps) != 1 || deps[0] != "1" {
+		t.Errorf("expected depends_on [1], got %v", result[0]["depends_on"])
+	}
+}
+
+func TestListCommand_Superseded(t *testing.T) {
+	tmpDir := setupListTest(t, map[string]string{
+		"001-old/SPEC.md":     "---\nstatus: approved\nsuperseded_by: 3\n---\n\n# Old\n",
+		"002-legacy/SPEC.md":  "---\nstatus: draft\n---\n\n# Legacy\n",
+		"003-new/SPEC.md":     "---\nstatus: draft\nsupersedes: [1, 2]\n---\n\n# New\n",
+		"004-current/SPEC.md": "---\nstatus: in-progress\n---\n\n# Current\n",
+	})
+
+	output, err := execList(t, tmpDir, map[string]string{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(output, "Old") || strings.Contains(output, "Legacy") {
+		t.Errorf("expected superseded specs to be hidden by default, got:\n%s", output)
+	}
+	if !strings.Contains(output, "New") || !strings.Contains(output, "Current") {
+		t.Errorf("expected current specs to be listed, got:\n%s", output)
+	}
+
+	output, err = execList(t, tmpDir, map[string]string{"superseded": "true"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, want := range []string{"approved (superseded)", "draft (superseded)"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected %q in output, got:\n%s", want, output)
+		}
+	}
+
+	output, err = execList(t, tmpDir, map[string]string{"status": "all", "format": "json"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var result []map[string]any
+	if err := json.Unmarshal([]byte(output), &result); err != nil {
+		t.Fatalf("failed to parse JSON: %v\noutput: %s", err, output)
+	}
+	if len(result) != 4 {
+		t.Fatalf("expected --status all to include superseded specs, got %d", len(result))
+	}
+	wantSuperseded := []bool{true, true, false, false}
+	for i, entry := range result {
+		if entry["superseded"] != wantSuperseded[i] {
+			t.Errorf("spec %v: expected superseded %v, got %v", entry["ref"], wantSuperseded[i], entry["superseded"])
+		}
+	}
+	if by, ok := result[0]["superseded_by"].([]any); !ok || len(by) != 1 || by[0] != "3" {
+		t.Errorf("expected superseded_by [3], got %v", result[0]["superseded_by"])
+	}
+}
+
+func TestListCommand_Progress(t *testing.T) {
+	tmpDir := setupListTest(t, map[string]string{
+		"001-planned/SPEC.md":   "---\nstatus: in-progress\n---\n\n# Planned\n\n- [x] Agree on design\n",
+		"001-planned/PLAN.md":   "# Plan\n\n### PR 1\n\n- [x] Parser\n- [ ] List column\n- [ ] Show breakdown\n",
+		"002-untracked/SPEC.md": "---\nstatus: draft\n---\n\n# Untracked\n",
+	})
+
+	output, err := execList(t, tmpDir, map[string]string{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected header and two rows, got:\n%s", output)
+	}
+	if !strings.Contains(lines[0], "STATUS       PROGRESS ") {
+		t.Errorf("expected PROGRESS column after STATUS, got %q", lines[0])
+	}
+	if !strings.Contains(lines[1], "in-progress  2/4 (50%)") {
+		t.Errorf("expected combined spec and plan progress, got %q", lines[1])
+	}
+
+	output, err = execList(t, tmpDir, map[string]string{"format": "json"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var result []map[string]any
+	if err := json.Unmarshal([]byte(output), &result); err != nil {
+		t.Fatalf("failed to parse JSON: %v\noutput: %s", err, output)
+	}
+	progress, ok := result[0]["progress"].(map[string]any)
+	if !ok || progress["done"] != float64(2) || progress["total"] != float64(4) {
+		t.Errorf("expected progress 2/4, got %v", result[0]["progress"])
+	}
+	progress, ok = result[1]["progress"].(map[string]any)
+	if !ok || progress["total"] != float64(0) {
+		t.Errorf("expected empty progress for spec without tasks, got %v", result[1]["progress"])
+	}
+
+	output, err = execList(t, tmpDir, map[string]string{"format": "text", "parent": "", "status": "draft"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(output, "PROGRESS") {
+		t.Errorf("expected PROGRESS column to be hidden without tasks, got:\n%s", output)
+	}
+}
+
+func TestListCommand_Labels(t *testing.T) {
+	tmpDir := setupListTest(t, map[string]string{
+		"001-cli/SPEC.md":        "---\nstatus: draft\nlabels: [cli]\n---\n\n# CLI Only\n",
+		"002-cli-docs/SPEC.md":   "---\nstatus: draft\nlabels: [cli, docs]\n---\n\n# CLI Docs\n",
+		"003-validation/SPEC.md": "---\nstatus: draft\nlabels: Validation\n---\n\n# Validation\n",
+		"004-unlabeled/SPEC.md":  "---\nstatus: draft\n---\n\n# Unlabeled\n",
+	})
+
+	names := func(output string) string {
+		var found []string
+		for _, name := range []string{"CLI Only", "CLI Docs", "Validation", "Unlabeled"} {
+			if strings.Contains(output, name+" ") {
+				found = append(found, name)
+			}
+		}
+		return strings.Join(found, "|")
+	}
+
+	tests := []struct {
+		filters []string
+		want    string
+	}{
+		{[]string{"cli"}, "CLI Only|CLI Docs"},
+		{[]string{"docs,validation"}, "CLI Docs|Validation"},
+		{[]string{"cli", "docs"}, "CLI Docs"},
+		{[]string{"cli", "!docs"}, "CLI Only"},
+		{[]string{"!cli"}, "Validation|Unlabeled"},
+	}
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.filters, " "), func(t *testing.T) {
+			for _, filter := range tt.filters {
+				listCmd.Flags().Set("label", filter)
+			}
+			output, err := execList(t, tmpDir, map[string]string{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := names(output); got != tt.want {
+				t.Errorf("expected %s, got %s:\n%s", tt.want, got, output)
+			}
+		})
+	}
+
+	output, err := execList(t, tmpDir, map[string]string{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(output, "LABELS") || !strings.Contains(output, "cli, docs") {
+		t.Errorf("expected LABELS column, got:\n%s", output)
+	}
+
+	output, err = execList(t, tmpDir, map[string]string{"format": "json"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var result []map[string]any
+	if err := json.Unmarshal([]byte(output), &result); err != nil {
+		t.Fatalf("failed to parse JSON: %v\noutput: %s", err, output)
+	}
+	if labels, ok := result[1]["labels"].([]any); !ok || len(labels) != 2 || labels[1] != "docs" {
+		t.Errorf("expected labels array, got %v", result[1]["labels"])
+	}
+	if labels, ok := result[3]["labels"].([]any); !ok || len(labels) != 0 {
+		t.Errorf("expected empty labels array, got %v", result[3]["labels"])
+	}
+}
+
+func TestListCommand_MilestoneAndSort(t *testing.T) {
+	tmpDir := setupListTest(t, map[string]string{
+		"001-low/SPEC.md":      "---\nstatus: draft\npriority: low\nmilestone: v1.0\n---\n\n# Low Priority\n",
+		"002-later/SPEC.md":    "---\nstatus: draft\npriority: critical\nmilestone: v2.0\n---\n\n# Later Release\n",
+		"003-none/SPEC.md":     "---\nstatus: approved\nmilestone: V1.0\n---\n\n# No Priority\n",
+		"004-critical/SPEC.md": "---\nstatus: in-progress\npriority: critical\nmilestone: v1.0\n---\n\n# Critical Fix\n",
+	})
+
+	refs := func(t *testing.T, flags map[string]string) string {
+		t.Helper()
+		flags["format"] = "json"
+		output, err := execList(t, tmpDir, flags)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var result []map[string]any
+		if err := json.Unmarshal([]byte(output), &result); err != nil {
+			t.Fatalf("failed to parse JSON: %v", err)
+		}
+		var found []string
+		for _, entry := range result {
+			found = append(found, entry["ref"].(string))
+		}
+		return strings.Join(found, " ")
+	}
+
+	tests := []struct {
+		flags map[string]string
+		want  string
+	}{
+		{map[string]string{"milestone": "v1.0"}, "1 3 4"},
+		{map[string]string{"milestone": "v1.0", "sort": "priority"}, "4 1 3"},
+		{map[string]string{"milestone": "v1.0", "sort": "priority", "reverse": "true"}, "1 4 3"},
+		{map[string]string{"milestone": "v1.0,v2.0", "sort": "priority"}, "2 4 1 3"},
+		{map[string]string{"sort": "status", "reverse": "true"}, "4 3 1 2"},
+		{map[string]string{"sort": "name"}, "4 2 1 3"},
+		{map[string]string{"reverse": "true"}, "4 3 2 1"},
+	}
+	for _, tt := range tests {
+		// Reset flags left over from the previous case.
+		flags := map[string]string{"milestone": "", "sort": "ref", "reverse": "false"}
+		for k, v := range tt.flags {
+			flags[k] = v
+		}
+		if got := refs(t, flags); got != tt.want {
+			t.Errorf("%v: expected %q, got %q", tt.flags, tt.want, got)
+		}
+	}
+
+	output, err := execList(t, tmpDir, map[string]string{"format": "text", "milestone": "", "sort": "priority", "reverse": "false"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(output, "PRIORITY") || !strings.Contains(output, "MILESTONE") {
+		t.Errorf("expected PRIORITY and MILESTONE columns, got:\n%s", output)
+	}
+	if strings.Index(output, "Critical Fix") > strings.Index(output, "Low Priority") {
+		t.Errorf("expected text output sorted by priority, got:\n%s", output)
+	}
+
+	if _, err := execList(t, tmpDir, map[string]string{"sort": "size"}); err == nil || !strings.Contains(err.Error(), "invalid sort key: size") {
+		t.Errorf("expected invalid sort key error, got %v", err)
+	}
+}
+
+func TestListCommand_Formats(t *testing.T) {
+	tmpDir := setupListTest(t, map[string]string{
+		"001-first/SPEC.md":  "---\nstatus: draft\npriority: high\nlabels: [cli]\n---\n\n# First | Feature\n",
+		"002-second/SPEC.md": "---\nstatus: approved\n---\n\n# Second Feature\n",
+	})
+
+	tests := []struct {
+		format  string
+		columns string
+		want    string
+	}{
+		{"csv", "", "ref,name,status,priority,milestone,progress,assignee,labels,path\n" +
+			"1,First | Feature,draft,high,,,,cli,specs/001-first/SPEC.md\n" +
+			"2,Second Feature,approved,,,,,,specs/002-second/SPEC.md\n"},
+		{"markdown", "", "| REF | NAME | STATUS | PRIORITY | LABELS | PATH |\n" +
+			"| --- | --- | --- | --- | --- | --- |\n" +
+			"| 1 | First \\| Feature | draft | high | cli | specs/001-first/SPEC.md |\n" +
+			"| 2 | Second Feature | approved |  |  | specs/002-second/SPEC.md |\n"},
+		{"ndjson", "ref,labels", "{\"ref\":\"1\",\"labels\":[\"cli\"]}\n{\"ref\":\"2\",\"labels\":[]}\n"},
+		{"yaml", "ref,priority", "- ref: \"1\"\n  priority: high\n- ref: \"2\"\n  priority: \"\"\n"},
+		{"text", "name,ref", "NAME             REF\nFirst | Feature  1  \nSecond Feature   2  \n"},
+		{"markdown", "ref,assignee", "| REF | ASSIGNEE |\n| --- | --- |\n| 1 |  |\n| 2 |  |\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format+" "+tt.columns, func(t *testing.T) {
+			output, err := execList(t, tmpDir, map[string]string{"format": tt.format, "columns": tt.columns})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if output != tt.want {
+				t.Errorf("unexpected output:\n%s\nwant:\n%s", output, tt.want)
+			}
+		})
+	}
+
+	_, err := execList(t, tmpDir, map[string]string{"format": "json", "columns": "ref,size"})
+	if err == nil || !strings.Contains(err.Error(), `unknown column "size"`) {
+		t.Errorf("expected unknown column error, got %v", err)
+	}
+}
+
+func TestListCommand_Where(t *testing.T) {
+	tmpDir := setupListTest(t, map[string]string{
+		"001-alice/SPEC.md":     "---\nstatus: draft\nassignee: Alice\nlabels: [cli]\ncreation_date: 2026-02-01\n---\n\n# Alice Draft\n",
+		"002-docs/SPEC.md":      "---\nstatus: approved\nassignee: Alice\nlabels: [cli, docs]\ncreation_date: 2026-02-01\n---\n\n# Alice Docs\n",
+		"003-old/SPEC.md":       "---\nstatus: approved\nassignee: Alice\ncreation_date: 2025-06-01\n---\n\n# Old Spec\n",
+		"004-completed/SPEC.md": "---\nstatus: completed\nteam: core\n---\n\n# Shipped\n",
+	})
+
+	tests := []struct {
+		where string
+		want  string
+	}{
+		{`status in (draft, approved) and assignee = "alice" and not label = docs and created > 2026-01-01`, "Alice Draft"},
+		{`label = cli`, "Alice Draft|Alice Docs"},
+		{`status = completed`, "Shipped"},
+		{`team = core`, ""},
+	}
+	for _, tt := range tests {
+		output, err := execList(t, tmpDir, map[string]string{"where": tt.where})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.where, err)
+		}
+		var found []string
+		for _, name := range []string{"Alice Draft", "Alice Docs", "Old Spec", "Shipped"} {
+			if strings.Contains(output, name) {
+				found = append(found, name)
+			}
+		}
+		if got := strings.Join(found, "|"); got != tt.want {
+			t.Errorf("%s: expected %q, got %q:\n%s", tt.where, tt.want, got, output)
+		}
+	}
+
+	_, err := execList(t, tmpDir, map[string]string{"where": "status = and"})
+	want := "invalid --where expression: expected a value after \"=\", found \"and\" at column 10\n  status = and\n           ^"
+	if err == nil || err.Error() != want {
+		t.Errorf("expected error:\n%s\ngot:\n%v", want, err)
+	}
+}
+
+func TestListCommand_WhereSourceValuesAndRanks(t *testing.T) {
+	tmpDir := setupListTest(t, map[string]string{
+		"001-urgent/SPEC.md": "---\nstatus: draft\npriority: critical\ntarget: 2026-03-01\n---\n\n# Urgent\n",
+		"002-normal/SPEC.md": "---\nstatus: draft\npriority: medium\ntarget: 2026-04-01\n---\n\n# Normal\n",
+		"003-later/SPEC.md":  "---\nstatus: draft\npriority: low\n---\n\n# Later\n",
+	})
+
+	tests := []struct {
+		where string
+		want  string
+	}{
+		{`target = 2026-03-01`, "Urgent"},
+		{`target < 2026-04-01`, "Urgent"},
+		{`priority < high`, "Urgent"},
+		{`priority >= medium`, "Normal|Later"},
+	}
+	// The second run reads the parse cache.
+	for run := 0; run < 2; run++ {
+		for _, tt := range tests {
+			output, err := execList(t, tmpDir, map[string]string{"where": tt.where})
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", tt.where, err)
+			}
+			var found []string
+			for _, name := range []string{"Urgent", "Normal", "Later"} {
+				if strings.Contains(output, name) {
+					found = append(found, name)
+				}
+			}
+			if got := strings.Join(found, "|"); got != tt.want {
+				t.Errorf("run %d, %s: expected %q, got %q:\n%s", run, tt.where, tt.want, got, output)
+			}
+		}
+	}
+}
+
+// commitAll commits every change in dir as author, dated daysAgo days ago.
+func commitAll(t *testing.T, dir, author string, daysAgo int) {
+	t.Helper()
+	date := time.Now().AddDate(0, 0, -daysAgo).Format(time.RFC3339)
+	for _, args := range [][]string{
+		{"add", "-A"},
+		{"commit", "-m", "update specs", "--date", date, "--author", author + " <" + strings.ToLower(author) + "@example.com>"},
+	} {
+		if err := testhelpers.RunGitCommand(dir, args); err != nil {
+			t.Fatalf("git %v failed: %v", args, err)
+		}
+	}
+}
+
+func TestListCommand_UpdatedSinceAndStale(t *testing.T) {
+	tmpDir := setupListTest(t, map[string]string{
+		"001-old/SPEC.md":    "---\nstatus: in-progress\n---\n\n# Old Feature\n",
+		"002-recent/SPEC.md": "---\nstatus: approved\n---\n\n# Recent Feature\n",
+		"003-draft/SPEC.md":  "---\nstatus: draft\n---\n\n# Draft Feature\n",
+	})
+	testhelpers.InitGitRepo(t, tmpDir)
+	commitAll(t, tmpDir, "Alice", 100)
+	testhelpers.WriteFile(t, tmpDir, "specs/002-recent/PLAN.md", "# Plan\n\n- [ ] Start\n")
+	commitAll(t, tmpDir, "Bob", 5)
+	testhelpers.WriteFile(t, tmpDir, "specs/004-new/SPEC.md", "---\nstatus: in-progress\n---\n\n# New Feature\n")
+
+	tests := []struct {
+		flags map[string]string
+		want  string
+	}{
+		{map[string]string{"stale": "90d"}, "1"},
+		{map[string]string{"stale": "90d", "status": "all"}, "1 3"},
+		{map[string]string{"stale": "2w", "where": "status = draft"}, "3"},
+		{map[string]string{"updated-since": "30d"}, "2 4"},
+		{map[string]string{"updated-since": "720h", "stale": "1d"}, "2"},
+		{map[string]string{"where": "updated_by = bob or created_by = nobody"}, "2"},
+	}
+	for _, tt := range tests {
+		flags := map[string]string{"format": "ndjson", "columns": "ref", "status": "", "where": "", "stale": "", "updated-since": ""}
+		for k, v := range tt.flags {
+			flags[k] = v
+		}
+		output, err := execList(t, tmpDir, flags)
+		if err != nil {
+			t.Fatalf("%v: unexpected error: %v", tt.flags, err)
+		}
+		var refs []string
+		for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+			var entry map[string]string
+			if line == "" {
+				continue
+			}
+			if err := json.Unmarshal([]byte(line), &entry); err != nil {
+				t.Fatalf("failed to parse %q: %v", line, err)
+			}
+			refs = append(refs, entry["ref"])
+		}
+		if got := strings.Join(refs, " "); got != tt.want {
+			t.Errorf("%v: expected %q, got %q", tt.flags, tt.want, got)
+		}
+	}
+
+	output, err := execList(t, tmpDir, map[string]string{"format": "json", "columns": "ref,created_by,updated_at,updated_by", "status": "all", "stale": "", "updated-since": "", "where": ""})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var entries []map[string]string
+	if err := json.Unmarshal([]byte(output), &entries); err != nil {
+		t.Fatalf("failed to parse JSON: %v\n%s", err, output)
+	}
+	if entries[1]["created_by"] != "Alice" || entries[1]["updated_by"] != "Bob" || entries[1]["updated_at"] == "" {
+		t.Errorf("expected git history for spec 2, got %v", entries[1])
+	}
+	if entries[3]["created_by"] != "" || entries[3]["updated_at"] != "" {
+		t.Errorf("expected no git history for uncommitted spec 4, got %v", entries[3])
+	}
+
+	_, err = execList(t, tmpDir, map[string]string{"stale": "soon"})
+	if err == nil || !strings.Contains(err.Error(), "invalid --stale value: soon") {
+		t.Errorf("expected invalid --stale error, got %v", err)
+	}
+}
+
+func TestListSpecs_LoadsHistoryOnlyWhenNeeded(t *testing.T) {
+	tmpDir := setupListTest(t, map[string]string{
+		"001-feature/SPEC.md": "---\nstatus: draft\n---\n\n# Feature\n",
+	})
+	testhelpers.InitGitRepo(t, tmpDir)
+	commitAll(t, tmpDir, "Alice", 1)
+
+	originalWd, _ := os.Getwd()
+	t.Cleanup(func() {
+		os.Chdir(originalWd)
+		resetFlags(listCmd)
+	})
+	os.Chdir(tmpDir)
+
+	tests := []struct {
+		flags   map[string]string
+		history bool
+		want    bool
+	}{
+		{nil, false, false},
+		{nil, true, true},
+		{map[string]string{"where": "status = draft"}, false, false},
+		{map[string]string{"where": "updated_by = alice"}, false, true},
+		{map[string]string{"sort": "updated"}, false, true},
+		{map[string]string{"updated-since": "30d"}, false, true},
+	}
+	for _, tt := range tests {
+		resetFlags(listCmd)
+		for k, v := range tt.flags {
+			listCmd.Flags().Set(k, v)
+		}
+		_, _, specs, err := listSpecs(listCmd, tt.history)
+		if err != nil {
+			t.Fatalf("%v: unexpected error: %v", tt.flags, err)
+		}
+		if len(specs) != 1 {
+			t.Fatalf("%v: expected one spec, got %d", tt.flags, len(specs))
+		}
+		if got := specs[0].CreatedBy != ""; got != tt.want {
+			t.Errorf("%v, history %v: history loaded = %v, want %v", tt.flags, tt.history, got, tt.want)
+		}
+	}
+
+	if showsHistory("", format.Formatter{}) || !showsHistory("ref,Updated_At", format.Formatter{}) {
+		t.Error("expected only history columns to need history")
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/specture-system/specture/internal/config"
 	"github.com/specture-system/specture/internal/new"
 	"github.com/spf13/cobra"
 )
@@ -19,7 +20,10 @@ Examples:
   specture new --title "My Spec" --parent 1.4
   specture new --title "My Plan" --plan
   specture new --title "Issue Spec" --spec 123
-  specture new --title "Child Spec" --spec 123.4`,
+  specture new --title "Child Spec" --spec 123.4
+
+Number padding and custom spec and plan templates can be set in .specture.yaml.
+Templates are Go templates rendered with .Title, .Author, and .CreationDate.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		cwd, err := os.Getwd()
 		if err != nil {
@@ -47,11 +51,31 @@ Examples:
 			return fmt.Errorf("failed to get plan flag: %w", err)
 		}
 
+		specsDir, err := resolveSpecsDir()
+		if err != nil {
+			return err
+		}
+		cfg, err := loadConfig()
+		if err != nil {
+			return err
+		}
+		var customTemplate string
+		if path := cfg.Templates.Path(config.ProjectDir(specsDir), plan); path != "" {
+			content, err := os.ReadFile(path)
+			if err != nil {
+				return fmt.Errorf("failed to read template: %w", err)
+			}
+			customTemplate = string(content)
+		}
+
 		ctx, err := new.NewContext(cwd, new.Options{
+			SpecsDir:  specsDir,
 			Title:     title,
 			ParentRef: parentRef,
 			SpecRef:   specRef,
 			Plan:      plan,
+			Padding:   cfg.Numbering.Padding,
+			Template:  customTemplate,
 		})
 		if err != nil {
 			return err
@@ -0,0 +1,306 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	planpkg "github.com/specture-system/specture/internal/plan"
+	specpkg "github.com/specture-system/specture/internal/spec"
+	"github.com/spf13/cobra"
+)
+
+var planShowFormatFlag string
+var planNextFormatFlag string
+
+var planCmd = &cobra.Command{
+	Use:   "plan <ref>",
+	Args:  cobra.ExactArgs(1),
+	Short: "Inspect a spec's PLAN.md",
+	Long: `Inspect the PLAN.md beside a spec as a list of pull request slices.
+
+Slices are the "### PR N: title" headings of the plan, usually under
+"## Pull Request Plan". Each slice's tasks are its list items. A slice is
+complete when it has task checkboxes ("- [ ]") and all of them are checked;
+slices written as plain bullets are never complete.
+
+Running specture plan <ref> is the same as specture plan show <ref>.
+
+Examples:
+  specture plan 4
+  specture plan show 4 -f json
+  specture plan next 4`,
+	RunE: runPlanShow,
+}
+
+var planShowCmd = &cobra.Command{
+	Use:   "show <ref>",
+	Args:  cobra.ExactArgs(1),
+	Short: "Show a plan's slices and progress",
+	Long: `Show a plan's slices, their task progress, and which slice is next.
+
+Use -f json for the full plan: slices with their tasks and markdown, the
+implementation notes, and the linked SPEC.md.
+
+Examples:
+  specture plan show 4
+  specture plan show 4 -f json`,
+	RunE: runPlanShow,
+}
+
+var planNextCmd = &cobra.Command{
+	Use:   "next <ref>",
+	Args:  cobra.ExactArgs(1),
+	Short: "Print the next incomplete slice of a plan",
+	Long: `Print the first incomplete slice of a plan as markdown, followed by the
+plan's implementation notes, so an agent can pick up exactly one slice.
+
+Examples:
+  specture plan next 4
+  specture plan next 4 -f json`,
+	RunE: runPlanNext,
+}
+
+func init() {
+	planCmd.Flags().StringVarP(&planShowFormatFlag, "format", "f", "text", "Output format: text or json")
+	planShowCmd.Flags().StringVarP(&planShowFormatFlag, "format", "f", "text", "Output format: text or json")
+	planNextCmd.Flags().StringVarP(&planNextFormatFlag, "format", "f", "text", "Output format: text or json")
+	planCmd.AddCommand(planShowCmd)
+	planCmd.AddCommand(planNextCmd)
+}
+
+// loadPlan resolves ref and parses the PLAN.md in the spec's directory.
+func loadPlan(ref string) (*specpkg.Node, *planpkg.Plan, error) {
+	specsDir, err := resolveSpecsDir()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tree, err := specpkg.BuildTree(specsDir)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	node, err := tree.Resolve(ref)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	path := filepath.Join(filepath.Dir(node.FilePath), planpkg.Filename)
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return nil, nil, fmt.Errorf("spec %s has no %s", node.Spec.FullRef, planpkg.Filename)
+	}
+	plan, err := planpkg.Parse(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	plan.Path = filepath.Join(filepath.Dir(node.Spec.Path), planpkg.Filename)
+	return node, plan, nil
+}
+
+func runPlanShow(cmd *cobra.Command, args []string) error {
+	format, _ := cmd.Flags().GetString("format")
+	if format != "text" && format != "json" {
+		return fmt.Errorf("invalid format: %s (must be 'text' or 'json')", format)
+	}
+
+	node, plan, err := loadPlan(args[0])
+	if err != nil {
+		return err
+	}
+
+	if format == "json" {
+		data, err := json.MarshalIndent(newPlanJSONOutput(node, plan), "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		cmd.Println(string(data))
+		return nil
+	}
+
+	cmd.Printf("Plan for %s: %s\n", node.Spec.FullRef, node.Spec.Name)
+	cmd.Printf("%-10s %s\n", "Path:", plan.Path)
+	if plan.SpecLink != "" {
+		cmd.Printf("%-10s %s\n", "Spec:", plan.SpecLink)
+	}
+	if progress := plan.Progress(); progress.Total > 0 {
+		cmd.Printf("%-10s %s tasks done (%d%%)\n", "Progress:", progress, progress.Percent())
+	}
+
+	cmd.Printf("\nSlices (%d):\n", len(plan.Slices))
+	if len(plan.Slices) == 0 {
+		cmd.Println("  (none)")
+		return nil
+	}
+	next := plan.Next()
+	width := 0
+	for _, slice := range plan.Slices {
+		width = max(width, len(slice.Heading))
+	}
+	for i, slice := range plan.Slices {
+		state := "todo"
+		switch {
+		case slice.Complete():
+			state = "done"
+		case next == &plan.Slices[i]:
+			state = "next"
+		}
+		cmd.Printf("  %-4s  %-*s  %s\n", state, width, slice.Heading, sliceTaskSummary(slice))
+	}
+	return nil
+}
+
+// sliceTaskSummary describes a slice's tasks as checkbox progress, or as a
+// task count when the slice has no checkboxes.
+func sliceTaskSummary(slice planpkg.Slice) string {
+	if progress := slice.Progress(); progress.Total > 0 {
+		return progress.String()
+	}
+	if len(slice.Tasks) == 1 {
+		return "1 task"
+	}
+	return fmt.Sprintf("%d tasks", len(slice.Tasks))
+}
+
+func runPlanNext(cmd *cobra.Command, args []string) error {
+	format, _ := cmd.Flags().GetString("format")
+	if format != "text" && format != "json" {
+		return fmt.Errorf("invalid format: %s (must be 'text' or 'json')", format)
+	}
+
+	node, plan, err := loadPlan(args[0])
+	if err != nil {
+		return err
+	}
+	next := plan.Next()
+
+	if format == "json" {
+		output := planNextJSONOutput{
+			Ref:      node.Spec.FullRef,
+			Name:     node.Spec.Name,
+			Path:     plan.Path,
+			SpecLink: plan.SpecLink,
+			Notes:    plan.Notes,
+		}
+		for _, slice := range plan.Slices {
+			if !slice.Complete() {
+				output.Remaining++
+			}
+		}
+		if next != nil {
+			slice := newPlanSliceJSON(*next)
+			output.Slice = &slice
+		}
+		data, err := json.MarshalIndent(output, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		cmd.Println(string(data))
+		return nil
+	}
+
+	if len(plan.Slices) == 0 {
+		return fmt.Errorf("%s has no \"### PR N\" slices", plan.Path)
+	}
+	if next == nil {
+		cmd.Printf("All slices of the plan for %s are complete.\n", node.Spec.FullRef)
+		return nil
+	}
+
+	cmd.Print(next.Markdown)
+	if plan.Notes != "" {
+		cmd.Printf("\n## Implementation Notes\n\n%s\n", plan.Notes)
+	}
+	if plan.SpecLink != "" {
+		cmd.Printf("\nSpec: %s\n", plan.SpecLink)
+	}
+	return nil
+}
+
+// planJSONOutput is the JSON shape of plan show.
+type planJSONOutput struct {
+	Ref      string           `json:"ref"`
+	Name     string           `json:"name"`
+	Path     string           `json:"path"`
+	Title    string           `json:"title"`
+	SpecLink string           `json:"spec_link"`
+	Progress specpkg.Progress `json:"progress"`
+	// Next is the number of the next incomplete slice, or null.
+	Next   *int            `json:"next"`
+	Slices []planSliceJSON `json:"slices"`
+	Notes  string          `json:"notes"`
+}
+
+// planNextJSONOutput is the JSON shape of plan next. Slice is null when every
+// slice is complete.
+type planNextJSONOutput struct {
+	Ref       string         `json:"ref"`
+	Name      string         `json:"name"`
+	Path      string         `json:"path"`
+	SpecLink  string         `json:"spec_link"`
+	Slice     *planSliceJSON `json:"slice"`
+	Remaining int            `json:"remaining"`
+	Notes     string         `json:"notes"`
+}
+
+// planSliceJSON is a plan slice in JSON output.
+type planSliceJSON struct {
+	Number   int              `json:"number"`
+	Title    string           `json:"title"`
+	Heading  string           `json:"heading"`
+	Complete bool             `json:"complete"`
+	Progress specpkg.Progress `json:"progress"`
+	Tasks    []planTaskJSON   `json:"tasks"`
+	Markdown string           `json:"markdown"`
+}
+
+// planTaskJSON is a slice task in JSON output. Done is null for plain list
+// items without a checkbox.
+type planTaskJSON struct {
+	Text  string `json:"text"`
+	Depth int    `json:"depth"`
+	Done  *bool  `json:"done"`
+}
+
+func newPlanJSONOutput(node *specpkg.Node, plan *planpkg.Plan) planJSONOutput {
+	output := planJSONOutput{
+		Ref:      node.Spec.FullRef,
+		Name:     node.Spec.Name,
+		Path:     plan.Path,
+		Title:    plan.Title,
+		SpecLink: plan.SpecLink,
+		Progress: plan.Progress(),
+		Slices:   make([]planSliceJSON, 0, len(plan.Slices)),
+		Notes:    plan.Notes,
+	}
+	if next := plan.Next(); next != nil {
+		output.Next = &next.Number
+	}
+	for _, slice := range plan.Slices {
+		output.Slices = append(output.Slices, newPlanSliceJSON(slice))
+	}
+	return output
+}
+
+func newPlanSliceJSON(slice planpkg.Slice) planSliceJSON {
+	output := planSliceJSON{
+		Number:   slice.Number,
+		Title:    slice.Title,
+		Heading:  slice.Heading,
+		Complete: slice.Complete(),
+		Progress: slice.Progress(),
+		Tasks:    make([]planTaskJSON, 0, len(slice.Tasks)),
+		Markdown: strings.TrimSuffix(slice.Markdown, "\n"),
+	}
+	for _, task := range slice.Tasks {
+		item := planTaskJSON{Text: task.Text, Depth: task.Depth}
+		if task.Checkbox {
+			done := task.Done
+			item.Done = &done
+		}
+		output.Tasks = append(output.Tasks, item)
+	}
+	return output
+}
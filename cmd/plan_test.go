@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+// Helper to run a plan subcommand and return the output and error.
+func execPlan(t *testing.T, tmpDir string, cmd *cobra.Command, run func(*cobra.Command, []string) error, format, ref string) (string, error) {
+	t.Helper()
+
+	originalWd, _ := os.Getwd()
+	t.Cleanup(func() {
+		os.Chdir(originalWd)
+		cmd.Flags().Set("format", "text")
+	})
+	os.Chdir(tmpDir)
+
+	out := &bytes.Buffer{}
+	cmd.SetOut(out)
+	cmd.SetErr(out)
+	cmd.Flags().Set("format", format)
+
+	err := run(cmd, []string{ref})
+	return out.String(), err
+}
+
+func setupPlanTest(t *testing.T, plan string) string {
+	t.Helper()
+	files := map[string]string{
+		"001-feature/SPEC.md":   "---\nstatus: in-progress\n---\n\n# Feature\n",
+		"002-unplanned/SPEC.md": "---\nstatus: draft\n---\n\n# Unplanned\n",
+	}
+	if plan != "" {
+		files["001-feature/PLAN.md"] = plan
+	}
+	return setupListTest(t, files)
+}
+
+const planCommandFixture = `# Feature Plan
+
+Implement [Feature](/specs/001-feature/SPEC.md).
+
+## Pull Request Plan
+
+### PR 1: Parser
+
+- [x] Parse
+
+### PR 2: Output
+
+- [ ] Print
+- [x] Format
+
+### PR 3: Docs
+
+- Write docs
+
+## Implementation Notes
+
+- Keep it simple.
+`
+
+func TestPlanShowCommand_TextOutput(t *testing.T) {
+	tmpDir := setupPlanTest(t, planCommandFixture)
+
+	output, err := execPlan(t, tmpDir, planShowCmd, runPlanShow, "text", "1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := "Plan for 1: Feature\n" +
+		"Path:      specs/001-feature/PLAN.md\n" +
+		"Spec:      /specs/001-feature/SPEC.md\n" +
+		"Progress:  2/3 tasks done (66%)\n" +
+		"\n" +
+		"Slices (3):\n" +
+		"  done  PR 1: Parser  1/1\n" +
+		"  next  PR 2: Output  1/2\n" +
+		"  todo  PR 3: Docs    1 task\n"
+	if output != want {
+		t.Errorf("unexpected output:\n%s\nwant:\n%s", output, want)
+	}
+}
+
+func TestPlanShowCommand_JSONOutput(t *testing.T) {
+	tmpDir := setupPlanTest(t, planCommandFixture)
+
+	output, err := execPlan(t, tmpDir, planShowCmd, runPlanShow, "json", "1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var result map[string]any
+	if err := json.Unmarshal([]byte(output), &result); err != nil {
+		t.Fatalf("failed to parse JSON: %v\noutput: %s", err, output)
+	}
+	if result["next"] != float64(2) || result["notes"] != "- Keep it simple." {
+		t.Errorf("unexpected plan JSON: %v", result)
+	}
+	slices, ok := result["slices"].([]any)
+	if !ok || len(slices) != 3 {
+		t.Fatalf("expected 3 slices, got %v", result["slices"])
+	}
+	docs := slices[2].(map[string]any)
+	tasks := docs["tasks"].([]any)
+	if task := tasks[0].(map[string]any); task["text"] != "Write docs" || task["done"] != nil {
+		t.Errorf("expected plain task with null done, got %v", task)
+	}
+}
+
+func TestPlanNextCommand(t *testing.T) {
+	tmpDir := setupPlanTest(t, planCommandFixture)
+
+	output, err := execPlan(t, tmpDir, planNextCmd, runPlanNext, "text", "1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "### PR 2: Output\n\n- [ ] Print\n- [x] Format\n\n## Implementation Notes\n\n- Keep it simple.\n\nSpec: /specs/001-feature/SPEC.md\n"
+	if output != want {
+		t.Errorf("unexpected output:\n%q\nwant:\n%q", output, want)
+	}
+
+	output, err = execPlan(t, tmpDir, planNextCmd, runPlanNext, "json", "1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var result map[string]any
+	if err := json.Unmarshal([]byte(output), &result); err != nil {
+		t.Fatalf("failed to parse JSON: %v\noutput: %s", err, output)
+	}
+	slice, ok := result["slice"].(map[string]any)
+	if !ok || slice["number"] != float64(2) || result["remaining"] != float64(2) {
+		t.Errorf("unexpected next JSON: %v", result)
+	}
+}
+
+func TestPlanNextCommand_AllComplete(t *testing.T) {
+	tmpDir := setupPlanTest(t, "# Plan\n\n### PR 1: Done\n\n- [x] Everything\n")
+
+	output, err := execPlan(t, tmpDir, planNextCmd, runPlanNext, "text", "1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output != "All slices of the plan for 1 are complete.\n" {
+		t.Errorf("unexpected output: %q", output)
+	}
+}
+
+func TestPlanCommand_MissingPlan(t *testing.T) {
+	tmpDir := setupPlanTest(t, "")
+
+	_, err := execPlan(t, tmpDir, planShowCmd, runPlanShow, "text", "2")
+	if err == nil || !strings.Contains(err.Error(), "spec 2 has no PLAN.md") {
+		t.Errorf("expected missing plan error, got %v", err)
+	}
+}
@@ -1,8 +1,6 @@
 package cmd
 
 import (
-	"fmt"
-	"os"
 	"path/filepath"
 
 	"github.com/specture-system/specture/internal/rename"
@@ -32,13 +30,11 @@ func init() {
 }
 
 func runRename(cmd *cobra.Command, args []string) error {
-	cwd, err := os.Getwd()
+	specsDir, err := resolveSpecsDir()
 	if err != nil {
-		return fmt.Errorf("failed to get current directory: %w", err)
+		return err
 	}
 
-	specsDir := filepath.Join(cwd, "specs")
-
 	specArg, _ := cmd.Flags().GetString("spec")
 	slug := args[0]
 	dryRun, _ := cmd.Flags().GetBool("dry-run")
@@ -48,7 +44,12 @@ func runRename(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	result, err := rename.Plan(specsDir, specArg, slug)
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	result, err := rename.Plan(specsDir, specArg, slug, cfg.Numbering.Padding)
 	if err != nil {
 		return err
 	}
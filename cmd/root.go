@@ -1,17 +1,64 @@
 package cmd
 
 import (
+	"fmt"
 	"os"
+	"path/filepath"
 
+	"github.com/specture-system/specture/internal/config"
+	specpkg "github.com/specture-system/specture/internal/spec"
 	"github.com/spf13/cobra"
 )
 
+var noCacheFlag bool
+var chdirFlag string
+var specsDirFlag string
+
+// projectConfig holds the configuration loaded at startup. Commands read it
+// through loadConfig, which falls back to loading on demand when the command
+// runs without the root command, as in tests.
+var projectConfig *config.Config
+
+// specsDirEnv names the environment variable that overrides specs directory
+// discovery.
+const specsDirEnv = "SPECTURE_SPECS_DIR"
+
 var rootCmd = &cobra.Command{
 	Use:   "specture",
 	Short: "A spec-driven software architecture system",
 	Long: `Specture is a spec-driven software architecture system. It provides a lightweight, document-driven approach to project planning.
 
-Spec numbers are derived from the directory tree. Specs live in directories with SPEC.md or PLAN.md files and may nest to any number of levels.`,
+Spec numbers are derived from the directory tree. Specs live in directories with SPEC.md or PLAN.md files and may nest to any number of levels.
+
+Parsed specs are cached in .specture/cache next to the specs directory and
+refreshed when a file's size or modification time changes. Use --no-cache to
+bypass the cache for a single invocation.
+
+The specs directory is found by walking up from the current directory to the
+nearest directory containing specs/ or .specture.yaml, stopping at the git
+repository root. Use --specs-dir or the SPECTURE_SPECS_DIR environment variable
+to point at a specs directory explicitly, and -C to run as if started in
+another directory.
+
+Project settings such as allowed statuses, default list filters, number
+padding, required frontmatter, templates, and validation rule severities are
+read from .specture.yaml next to the specs directory. Run "specture config show"
+to print the effective configuration.`,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if chdirFlag != "" {
+			if err := os.Chdir(chdirFlag); err != nil {
+				return fmt.Errorf("failed to change directory: %w", err)
+			}
+		}
+		specpkg.SetCacheEnabled(!noCacheFlag)
+
+		cfg, err := readConfig()
+		if err != nil {
+			return err
+		}
+		projectConfig = cfg
+		return nil
+	},
 }
 
 func Execute() {
@@ -22,9 +69,62 @@ func Execute() {
 }
 
 func init() {
+	rootCmd.PersistentFlags().StringVarP(&chdirFlag, "chdir", "C", "", "Run as if specture was started in this directory")
+	rootCmd.PersistentFlags().StringVar(&specsDirFlag, "specs-dir", "", "Path to the specs directory (default: discovered from the current directory, or $"+specsDirEnv+")")
+	rootCmd.PersistentFlags().BoolVar(&noCacheFlag, "no-cache", false, "Parse every spec from disk without reading or writing the spec cache")
+
 	rootCmd.AddCommand(newCmd)
 	rootCmd.AddCommand(validateCmd)
 	rootCmd.AddCommand(listCmd)
+	rootCmd.AddCommand(treeCmd)
 	rootCmd.AddCommand(renameCmd)
 	rootCmd.AddCommand(viewCmd)
+	rootCmd.AddCommand(linksCmd)
+	rootCmd.AddCommand(configCmd)
+	rootCmd.AddCommand(statusCmd)
+	rootCmd.AddCommand(showCmd)
+	rootCmd.AddCommand(searchCmd)
+	rootCmd.AddCommand(planCmd)
+	rootCmd.AddCommand(changedCmd)
+}
+
+// resolveSpecsDir returns the absolute specs directory for the current
+// invocation. --specs-dir takes precedence over SPECTURE_SPECS_DIR, and both
+// take precedence over discovery from the working directory.
+func resolveSpecsDir() (string, error) {
+	dir := specsDirFlag
+	if dir == "" {
+		dir = os.Getenv(specsDirEnv)
+	}
+	if dir != "" {
+		absDir, err := filepath.Abs(dir)
+		if err != nil {
+			return "", fmt.Errorf("failed to resolve specs directory: %w", err)
+		}
+		return absDir, nil
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return "", fmt.Errorf("failed to get current directory: %w", err)
+	}
+	return specpkg.FindSpecsDir(cwd)
+}
+
+// loadConfig returns the project configuration for the current invocation.
+func loadConfig() (*config.Config, error) {
+	if projectConfig != nil {
+		return projectConfig, nil
+	}
+	return readConfig()
+}
+
+// readConfig loads .specture.yaml from the project root above the specs
+// directory.
+func readConfig() (*config.Config, error) {
+	specsDir, err := resolveSpecsDir()
+	if err != nil {
+		return nil, err
+	}
+	return config.Load(config.ProjectDir(specsDir))
 }
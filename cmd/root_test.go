@@ -2,6 +2,8 @@ package cmd
 
 import (
 	"bytes"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 )
@@ -101,3 +103,70 @@ func TestRootCommand_VersionFlagTruncatesCommitHash(t *testing.T) {
 		t.Fatalf("expected version output %q, got %q", "v0.3.0 (c872008)", got)
 	}
 }
+
+func TestResolveSpecsDir(t *testing.T) {
+	tmpDir := setupListTest(t, map[string]string{
+		"001-feature/SPEC.md": "---\nstatus: draft\n---\n\n# Feature\n",
+	})
+	subDir := filepath.Join(tmpDir, "internal", "pkg")
+	if err := os.MkdirAll(subDir, 0755); err != nil {
+		t.Fatalf("failed to create subdirectory: %v", err)
+	}
+
+	originalWd, _ := os.Getwd()
+	t.Cleanup(func() {
+		os.Chdir(originalWd)
+		specsDirFlag = ""
+	})
+	os.Chdir(subDir)
+
+	wantDiscovered, _ := filepath.EvalSymlinks(filepath.Join(tmpDir, "specs"))
+	got, err := resolveSpecsDir()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, _ = filepath.EvalSymlinks(got); got != wantDiscovered {
+		t.Errorf("expected discovered specs dir %s, got %s", wantDiscovered, got)
+	}
+
+	envDir := t.TempDir()
+	t.Setenv(specsDirEnv, envDir)
+	if got, err := resolveSpecsDir(); err != nil || got != envDir {
+		t.Errorf("expected %s from environment, got %s (err: %v)", envDir, got, err)
+	}
+
+	flagDir := t.TempDir()
+	specsDirFlag = flagDir
+	if got, err := resolveSpecsDir(); err != nil || got != flagDir {
+		t.Errorf("expected --specs-dir %s to win over environment, got %s (err: %v)", flagDir, got, err)
+	}
+}
+
+func TestRootCommand_ChdirFlag(t *testing.T) {
+	tmpDir := setupListTest(t, map[string]string{
+		"001-feature/SPEC.md": "---\nstatus: draft\n---\n\n# Chdir Feature\n",
+	})
+
+	originalWd, _ := os.Getwd()
+	t.Cleanup(func() {
+		os.Chdir(originalWd)
+		chdirFlag = ""
+		projectConfig = nil
+		rootCmd.SetArgs(nil)
+		listCmd.Flags().Set("format", "text")
+		resetChanged(listCmd, "format")
+	})
+
+	out := &bytes.Buffer{}
+	rootCmd.SetOut(out)
+	rootCmd.SetErr(out)
+	listCmd.SetOut(out)
+	rootCmd.SetArgs([]string{"-C", filepath.Join(tmpDir, "specs", "001-feature"), "list"})
+
+	if err := rootCmd.Execute(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(out.String(), "Chdir Feature") {
+		t.Errorf("expected spec listed after -C, got:\n%s", out.String())
+	}
+}
@@ -0,0 +1,171 @@
+package cmd
+
+import (
+	"strings"
+
+	"github.com/specture-system/specture/internal/format"
+	searchpkg "github.com/specture-system/specture/internal/search"
+	specpkg "github.com/specture-system/specture/internal/spec"
+	"github.com/spf13/cobra"
+)
+
+var searchStatusFilter string
+var searchFormatFlag string
+var searchParentFlag string
+var searchSupersededFlag bool
+
+var searchCmd = &cobra.Command{
+	Use:   "search <query>",
+	Args:  cobra.MinimumNArgs(1),
+	Short: "Search spec titles, headings, and text",
+	Long: `Search the title, headings, and body text of every SPEC.md and PLAN.md.
+
+A spec matches when it contains every word of the query, ignoring case.
+Results are ranked so that matches in the title count most, then matches in
+headings, then matches in body text. Each result shows the ref, title, status,
+and the best matching line with the query words highlighted.
+
+Results are filtered like specture list: completed and superseded specs are
+hidden by default. Use --status to choose statuses (or --status all), --parent
+to search under one spec, and --superseded to include superseded specs.
+
+Use --format to choose the output: text, csv, markdown, json, ndjson, or yaml.
+Formats other than text list the ref, name, status, path, superseded flag,
+score, matched file, snippet, and highlight byte ranges of each result.
+
+Examples:
+  specture search frontmatter numbering
+  specture search "status transitions" --status all
+  specture search cache --parent 4
+  specture search cache -f json
+  specture search cache -f csv`,
+	RunE: runSearch,
+}
+
+func init() {
+	searchCmd.Flags().StringVarP(&searchStatusFilter, "status", "s", "", `Filter by status (comma-separated for multiple); use "all" for all statuses`)
+	searchCmd.Flags().StringVarP(&searchFormatFlag, "format", "f", "text", "Output format: "+strings.Join(format.Names(), ", "))
+	searchCmd.Flags().StringVarP(&searchParentFlag, "parent", "p", "", "Parent spec reference to search under")
+	searchCmd.Flags().BoolVar(&searchSupersededFlag, "superseded", false, "Include specs that have been superseded by another spec")
+}
+
+func runSearch(cmd *cobra.Command, args []string) error {
+	formatName, _ := cmd.Flags().GetString("format")
+	formatter, err := format.Get(formatName)
+	if err != nil {
+		return err
+	}
+
+	specsDir, err := resolveSpecsDir()
+	if err != nil {
+		return err
+	}
+
+	tree, err := specpkg.BuildTree(specsDir)
+	if err != nil {
+		return err
+	}
+
+	parentRef, _ := cmd.Flags().GetString("parent")
+	var parentPath string
+	if strings.TrimSpace(parentRef) != "" {
+		parent, err := tree.Resolve(parentRef)
+		if err != nil {
+			return err
+		}
+		parentPath = parent.FilePath
+	}
+
+	nodes, err := tree.ScopeDepth(parentPath, 0)
+	if err != nil {
+		return err
+	}
+	specs := make([]*specpkg.SpecInfo, 0, len(nodes))
+	for _, node := range nodes {
+		specs = append(specs, node.Spec)
+	}
+
+	statusFilter, _ := cmd.Flags().GetString("status")
+	includeSuperseded, _ := cmd.Flags().GetBool("superseded")
+	specs, err = filterStatuses(tree, specs, statusFilter, includeSuperseded)
+	if err != nil {
+		return err
+	}
+	nodes = nodes[:0]
+	for _, spec := range specs {
+		nodes = append(nodes, tree.NodeForSpec(spec))
+	}
+
+	results, err := searchpkg.Search(nodes, strings.Join(args, " "))
+	if err != nil {
+		return err
+	}
+
+	if formatter.Name == "text" {
+		return formatSearchText(cmd, tree, results)
+	}
+	return writeSearchResults(cmd, formatter, tree, results)
+}
+
+// formatSearchText prints each result as a ref/title/status row followed by
+// its snippet, with matches wrapped in ** markers.
+func formatSearchText(cmd *cobra.Command, tree *specpkg.Tree, results []searchpkg.Result) error {
+	if len(results) == 0 {
+		cmd.Println("No matching specs found")
+		return nil
+	}
+
+	refWidth := 0
+	for _, result := range results {
+		refWidth = max(refWidth, len(result.Node.Spec.FullRef))
+	}
+	indent := strings.Repeat(" ", refWidth+2)
+
+	for _, result := range results {
+		spec := result.Node.Spec
+		cmd.Printf("%-*s  %s (%s)\n", refWidth, spec.FullRef, spec.Name, displayStatus(tree, spec))
+		if result.Snippet != "" {
+			cmd.Printf("%s%s: %s\n", indent, result.File, searchpkg.Highlight(result.Snippet, result.Highlights, "**", "**"))
+		}
+	}
+	return nil
+}
+
+// searchColumns are the fields of search results in formats other than
+// text, in output order.
+var searchColumns = []format.Column{
+	{Name: "ref", Header: "REF"},
+	{Name: "name", Header: "NAME"},
+	{Name: "status", Header: "STATUS"},
+	{Name: "path", Header: "PATH"},
+	{Name: "superseded", Header: "SUPERSEDED"},
+	{Name: "score", Header: "SCORE"},
+	{Name: "file", Header: "FILE"},
+	{Name: "snippet", Header: "SNIPPET"},
+	{Name: "highlights", Header: "HIGHLIGHTS"},
+}
+
+// writeSearchResults outputs results in rank order with the formatter.
+// Highlights are byte offsets into snippet.
+func writeSearchResults(cmd *cobra.Command, formatter format.Formatter, tree *specpkg.Tree, results []searchpkg.Result) error {
+	table := format.Table{Columns: searchColumns}
+	for _, result := range results {
+		spec := result.Node.Spec
+		highlights := result.Highlights
+		if highlights == nil {
+			highlights = []searchpkg.Range{}
+		}
+		table.Rows = append(table.Rows, []format.Cell{
+			format.Value(spec.FullRef),
+			format.Value(spec.Name),
+			format.Value(spec.Status),
+			format.Value(spec.Path),
+			format.Value(tree.IsSuperseded(result.Node)),
+			format.Value(result.Score),
+			format.Value(result.File),
+			format.Value(result.Snippet),
+			format.Value(highlights),
+		})
+	}
+	return formatter.Write(cmd.OutOrStdout(), table)
+}
@@ -0,0 +1,140 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+)
+
+// Helper to run the search command and return the output and error.
+func execSearch(t *testing.T, tmpDir string, flags map[string]string, query ...string) (string, error) {
+	t.Helper()
+
+	originalWd, _ := os.Getwd()
+	t.Cleanup(func() {
+		os.Chdir(originalWd)
+		searchCmd.Flags().Set("status", "")
+		searchCmd.Flags().Set("format", "text")
+		searchCmd.Flags().Set("parent", "")
+		searchCmd.Flags().Set("superseded", "false")
+	})
+	os.Chdir(tmpDir)
+
+	out := &bytes.Buffer{}
+	cmd := searchCmd
+	cmd.SetOut(out)
+	cmd.SetErr(out)
+	for k, v := range flags {
+		cmd.Flags().Set(k, v)
+	}
+
+	err := runSearch(cmd, query)
+	return out.String(), err
+}
+
+func setupSearchTest(t *testing.T) string {
+	t.Helper()
+	return setupListTest(t, map[string]string{
+		"001-numbering/SPEC.md":          "---\nstatus: approved\n---\n\n# Numbering\n\nFrontmatter numbering rules.\n",
+		"002-cache/SPEC.md":              "---\nstatus: draft\n---\n\n# Cache\n\n## Frontmatter\n\nCache keys.\n",
+		"002-cache/001-eviction/SPEC.md": "---\nstatus: draft\n---\n\n# Eviction\n\nEvict stale frontmatter.\n",
+		"003-done/SPEC.md":               "---\nstatus: completed\n---\n\n# Done\n\nOld frontmatter work.\n",
+	})
+}
+
+func TestSearchCommand_TextOutput(t *testing.T) {
+	tmpDir := setupSearchTest(t)
+
+	output, err := execSearch(t, tmpDir, nil, "frontmatter")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := "2    Cache (draft)\n" +
+		"     SPEC.md: **Frontmatter**\n" +
+		"1    Numbering (approved)\n" +
+		"     SPEC.md: **Frontmatter** numbering rules.\n" +
+		"2.1  Eviction (draft)\n" +
+		"     SPEC.md: Evict stale **frontmatter**.\n"
+	if output != want {
+		t.Errorf("unexpected output:\n%s\nwant:\n%s", output, want)
+	}
+}
+
+func TestSearchCommand_Filters(t *testing.T) {
+	tmpDir := setupSearchTest(t)
+
+	output, err := execSearch(t, tmpDir, map[string]string{"status": "all", "parent": "2"}, "frontmatter")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(output, "Numbering") || !strings.Contains(output, "Eviction") {
+		t.Errorf("expected only specs under 2, got:\n%s", output)
+	}
+
+	output, err = execSearch(t, tmpDir, map[string]string{"status": "completed", "parent": ""}, "frontmatter")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.HasPrefix(output, "3  Done (completed)\n") {
+		t.Errorf("expected completed spec, got:\n%s", output)
+	}
+
+	output, err = execSearch(t, tmpDir, map[string]string{"status": ""}, "missing")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output != "No matching specs found\n" {
+		t.Errorf("unexpected output: %q", output)
+	}
+}
+
+func TestSearchCommand_JSONOutput(t *testing.T) {
+	tmpDir := setupSearchTest(t)
+
+	output, err := execSearch(t, tmpDir, map[string]string{"format": "json"}, "frontmatter", "numbering")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var results []map[string]any
+	if err := json.Unmarshal([]byte(output), &results); err != nil {
+		t.Fatalf("failed to parse JSON: %v\noutput: %s", err, output)
+	}
+	if len(results) != 1 {
+		t.Fatalf("expected 1 result, got %d", len(results))
+	}
+	result := results[0]
+	if result["ref"] != "1" || result["file"] != "SPEC.md" || result["snippet"] != "Frontmatter numbering rules." {
+		t.Errorf("unexpected result: %v", result)
+	}
+	if highlights, ok := result["highlights"].([]any); !ok || len(highlights) != 2 {
+		t.Errorf("expected two highlights, got %v", result["highlights"])
+	}
+	if _, ok := result["score"].(float64); !ok {
+		t.Errorf("expected numeric score, got %v", result["score"])
+	}
+}
+
+func TestSearchCommand_NDJSONOutput(t *testing.T) {
+	tmpDir := setupSearchTest(t)
+
+	output, err := execSearch(t, tmpDir, map[string]string{"format": "ndjson"}, "frontmatter", "numbering")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(output, "\n"), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("expected one line per result, got %q", output)
+	}
+	var result map[string]any
+	if err := json.Unmarshal([]byte(lines[0]), &result); err != nil {
+		t.Fatalf("failed to parse JSON line: %v", err)
+	}
+	if result["ref"] != "1" || result["snippet"] != "Frontmatter numbering rules." {
+		t.Errorf("unexpected result: %v", result)
+	}
+}
@@ -0,0 +1,342 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	specpkg "github.com/specture-system/specture/internal/spec"
+	"github.com/spf13/cobra"
+)
+
+var showFormatFlag string
+var showTemplateFlag string
+var showTemplateFileFlag string
+
+var showCmd = &cobra.Command{
+	Use:   "show <ref>",
+	Args:  cobra.ExactArgs(1),
+	Short: "Show a spec's metadata",
+	Long: `Show one spec's metadata without opening the file.
+
+Prints the ref, title, status, priority, milestone, assignee, author, dates,
+the first and latest commits from git history, parent, children, plan
+presence, section headings, link counts, and task checkbox progress broken
+down by the sections of SPEC.md and PLAN.md, such as a plan's "### PR N"
+slices. Use -f json for a stable machine-readable schema.
+
+` + templateHelp + `
+
+Examples:
+  specture show 4
+  specture show 4.2
+  specture show specs/004-list-command/SPEC.md
+  specture show 4 -f json
+  specture show 4 --template '{{.Ref}} {{.Progress.Percent}}%'`,
+	RunE: runShow,
+}
+
+func init() {
+	showCmd.Flags().StringVarP(&showFormatFlag, "format", "f", "text", "Output format: text, json, or template")
+	addTemplateFlags(showCmd, &showTemplateFlag, &showTemplateFileFlag)
+}
+
+func runShow(cmd *cobra.Command, args []string) error {
+	format, err := resolveFormat(cmd)
+	if err != nil {
+		return err
+	}
+	if format != "text" && format != "json" && format != templateFormat {
+		return fmt.Errorf("invalid format: %s (must be 'text', 'json', or 'template')", format)
+	}
+
+	specsDir, err := resolveSpecsDir()
+	if err != nil {
+		return err
+	}
+
+	tree, err := specpkg.BuildTree(specsDir)
+	if err != nil {
+		return err
+	}
+
+	node, err := tree.Resolve(args[0])
+	if err != nil {
+		return err
+	}
+	if err := tree.LoadHistory(node); err != nil {
+		return err
+	}
+
+	if format == templateFormat {
+		t, err := loadOutputTemplate(cmd, tree)
+		if err != nil {
+			return err
+		}
+		return writeTemplate(cmd, t, tree, []*specpkg.Node{node})
+	}
+
+	output := newShowJSONOutput(tree, node, len(tree.Inbound(node)))
+	if format == "json" {
+		data, err := json.MarshalIndent(output, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		cmd.Println(string(data))
+		return nil
+	}
+
+	formatShowText(cmd, output)
+	return nil
+}
+
+// showJSONOutput is the JSON shape of the show command.
+type showJSONOutput struct {
+	Ref          string            `json:"ref"`
+	Name         string            `json:"name"`
+	Status       string            `json:"status"`
+	Assignee     string            `json:"assignee"`
+	Assignees    []string          `json:"assignees"`
+	Labels       []string          `json:"labels"`
+	Priority     string            `json:"priority"`
+	Milestone    string            `json:"milestone"`
+	Author       string            `json:"author"`
+	CreationDate string            `json:"creation_date"`
+	ApprovedBy   string            `json:"approved_by"`
+	ApprovalDate string            `json:"approval_date"`
+	Path         string            `json:"path"`
+	Parent       *specSummaryJSON  `json:"parent"`
+	Children     []specSummaryJSON `json:"children"`
+	HasPlan      bool              `json:"has_plan"`
+	Sections     []specpkg.Section `json:"sections"`
+	Links        showLinksJSON     `json:"links"`
+	DependsOn    []string          `json:"depends_on"`
+	Blocks       []string          `json:"blocks"`
+	Supersedes   []string          `json:"supersedes"`
+	SupersededBy []string          `json:"superseded_by"`
+	Superseded   bool              `json:"superseded"`
+	Progress     showProgressJSON  `json:"progress"`
+	Extra        map[string]any    `json:"extra"`
+	CreatedAt    string            `json:"created_at"`
+	CreatedBy    string            `json:"created_by"`
+	UpdatedAt    string            `json:"updated_at"`
+	UpdatedBy    string            `json:"updated_by"`
+}
+
+// showProgressJSON is a spec's combined task progress with a per-document
+// breakdown.
+type showProgressJSON struct {
+	specpkg.Progress
+	Spec showDocumentProgressJSON `json:"spec"`
+	Plan showDocumentProgressJSON `json:"plan"`
+}
+
+// showDocumentProgressJSON is the task progress of one document and of each
+// of its sections that contains tasks.
+type showDocumentProgressJSON struct {
+	specpkg.Progress
+	Sections []specpkg.SectionProgress `json:"sections"`
+}
+
+func newShowDocumentProgressJSON(progress specpkg.Progress, sections []specpkg.SectionProgress) showDocumentProgressJSON {
+	if sections == nil {
+		sections = []specpkg.SectionProgress{}
+	}
+	return showDocumentProgressJSON{Progress: progress, Sections: sections}
+}
+
+// specSummaryJSON identifies a related spec.
+type specSummaryJSON struct {
+	Ref    string `json:"ref"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Path   string `json:"path"`
+}
+
+// showLinksJSON counts a spec's links. Broken counts outbound links that do
+// not resolve to a spec.
+type showLinksJSON struct {
+	Outbound int `json:"outbound"`
+	Inbound  int `json:"inbound"`
+	Broken   int `json:"broken"`
+}
+
+func newShowJSONOutput(tree *specpkg.Tree, node *specpkg.Node, inbound int) showJSONOutput {
+	info := node.Spec
+	output := showJSONOutput{
+		Ref:          info.FullRef,
+		Name:         info.Name,
+		Status:       info.Status,
+		Assignee:     info.AssigneeList(),
+		Assignees:    nonNilStrings(info.Assignees),
+		Labels:       nonNilStrings(info.Labels),
+		Priority:     info.Priority,
+		Milestone:    info.Milestone,
+		Author:       info.Author,
+		CreationDate: info.CreationDate,
+		ApprovedBy:   info.ApprovedBy,
+		ApprovalDate: info.ApprovalDate,
+		Path:         info.Path,
+		Children:     make([]specSummaryJSON, 0, len(node.Children)),
+		HasPlan:      info.HasPlan,
+		Sections:     info.Sections,
+		DependsOn:    nonNilStrings(info.DependsOn),
+		Blocks:       nonNilStrings(info.Blocks),
+		Supersedes:   nonNilStrings(info.Supersedes),
+		SupersededBy: nonNilStrings(info.SupersededBy),
+		Superseded:   tree.IsSuperseded(node),
+		Progress: showProgressJSON{
+			Progress: info.TotalProgress(),
+			Spec:     newShowDocumentProgressJSON(info.Progress, info.SectionProgress),
+			Plan:     newShowDocumentProgressJSON(info.PlanProgress, info.PlanSectionProgress),
+		},
+		Extra:     info.Extra,
+		CreatedAt: formatTimestamp(info.CreatedAt),
+		CreatedBy: info.CreatedBy,
+		UpdatedAt: formatTimestamp(info.UpdatedAt),
+		UpdatedBy: info.UpdatedBy,
+	}
+	if output.Sections == nil {
+		output.Sections = []specpkg.Section{}
+	}
+	if output.Extra == nil {
+		output.Extra = map[string]any{}
+	}
+	if node.Parent != nil {
+		parent := newSpecSummaryJSON(node.Parent)
+		output.Parent = &parent
+	}
+	for _, child := range node.Children {
+		output.Children = append(output.Children, newSpecSummaryJSON(child))
+	}
+
+	for _, ref := range tree.Outbound(node) {
+		output.Links.Outbound++
+		if ref.To == nil {
+			output.Links.Broken++
+		}
+	}
+	output.Links.Inbound = inbound
+
+	return output
+}
+
+func newSpecSummaryJSON(node *specpkg.Node) specSummaryJSON {
+	return specSummaryJSON{
+		Ref:    node.Spec.FullRef,
+		Name:   node.Spec.Name,
+		Status: node.Spec.Status,
+		Path:   node.Spec.Path,
+	}
+}
+
+// formatShowText prints a spec's metadata as an aligned label/value block.
+// Optional fields are omitted when empty.
+func formatShowText(cmd *cobra.Command, output showJSONOutput) {
+	row := func(label, value string) {
+		if value != "" {
+			cmd.Printf("%-15s %s\n", label+":", value)
+		}
+	}
+
+	status := output.Status
+	if output.Superseded {
+		status += " (superseded)"
+	}
+	approval := output.ApprovalDate
+	if output.ApprovedBy != "" {
+		approval = strings.TrimSpace(approval + " by " + output.ApprovedBy)
+	}
+
+	row("Ref", output.Ref)
+	row("Title", output.Name)
+	row("Status", status)
+	row("Priority", output.Priority)
+	row("Milestone", output.Milestone)
+	row("Assignee", output.Assignee)
+	row("Labels", strings.Join(output.Labels, ", "))
+	row("Author", output.Author)
+	row("Created", output.CreationDate)
+	row("Approved", approval)
+	row("First commit", commitSummary(output.CreatedAt, output.CreatedBy))
+	row("Last commit", commitSummary(output.UpdatedAt, output.UpdatedBy))
+	row("Path", output.Path)
+	if output.Parent != nil {
+		row("Parent", output.Parent.Ref+"  "+output.Parent.Name)
+	}
+	row("Plan", yesNo(output.HasPlan))
+	row("Depends on", strings.Join(output.DependsOn, ", "))
+	row("Blocks", strings.Join(output.Blocks, ", "))
+	row("Supersedes", strings.Join(output.Supersedes, ", "))
+	row("Superseded by", strings.Join(output.SupersededBy, ", "))
+
+	links := fmt.Sprintf("%d outbound, %d inbound", output.Links.Outbound, output.Links.Inbound)
+	if output.Links.Broken > 0 {
+		links += fmt.Sprintf(" (%d not found)", output.Links.Broken)
+	}
+	row("Links", links)
+	if output.Progress.Total > 0 {
+		row("Progress", fmt.Sprintf("%s tasks done (%d%%)", output.Progress.Progress, output.Progress.Percent()))
+	}
+
+	cmd.Printf("\nChildren (%d):\n", len(output.Children))
+	if len(output.Children) == 0 {
+		cmd.Println("  (none)")
+	}
+	refWidth := 0
+	for _, child := range output.Children {
+		refWidth = max(refWidth, len(child.Ref))
+	}
+	for _, child := range output.Children {
+		cmd.Printf("  %-*s  %s (%s)\n", refWidth, child.Ref, child.Name, child.Status)
+	}
+
+	cmd.Printf("\nSections (%d):\n", len(output.Sections))
+	if len(output.Sections) == 0 {
+		cmd.Println("  (none)")
+	}
+	for _, section := range output.Sections {
+		cmd.Printf("%s%s\n", strings.Repeat("  ", section.Level-1), section.Title)
+	}
+
+	if output.Progress.Total > 0 {
+		cmd.Printf("\nTasks (%s):\n", output.Progress.Progress)
+		printTaskSections(cmd, "SPEC.md", output.Progress.Spec)
+		printTaskSections(cmd, "PLAN.md", output.Progress.Plan)
+	}
+}
+
+// commitSummary formats a commit timestamp and author as "date by author",
+// or "" when the timestamp is unknown.
+func commitSummary(timestamp, author string) string {
+	date, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(date.Local().Format(time.DateOnly) + " by " + author)
+}
+
+// printTaskSections prints a document's task progress followed by the
+// progress of each section containing tasks, indented by heading level.
+func printTaskSections(cmd *cobra.Command, document string, progress showDocumentProgressJSON) {
+	if progress.Total == 0 {
+		return
+	}
+	width := len(document)
+	for _, section := range progress.Sections {
+		width = max(width, 2*(section.Level-1)+len(section.Title))
+	}
+	cmd.Printf("  %-*s  %s\n", width, document, progress.Progress)
+	for _, section := range progress.Sections {
+		label := strings.Repeat("  ", section.Level-1) + section.Title
+		cmd.Printf("  %-*s  %s\n", width, label, section.Progress)
+	}
+}
+
+func yesNo(value bool) string {
+	if value {
+		return "yes"
+	}
+	return "no"
+}
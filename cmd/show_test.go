@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+)
+
+// Helper to run the show command and return the output and error.
+func execShow(t *testing.T, tmpDir string, format string, ref string) (string, error) {
+	t.Helper()
+
+	originalWd, _ := os.Getwd()
+	t.Cleanup(func() {
+		os.Chdir(originalWd)
+		showCmd.Flags().Set("format", "text")
+		showCmd.Flags().Set("template", "")
+		resetChanged(showCmd, "format", "template")
+	})
+	os.Chdir(tmpDir)
+
+	out := &bytes.Buffer{}
+	cmd := showCmd
+	cmd.SetOut(out)
+	cmd.SetErr(out)
+	cmd.Flags().Set("format", format)
+
+	err := runShow(cmd, []string{ref})
+	return out.String(), err
+}
+
+func setupShowTest(t *testing.T) string {
+	t.Helper()
+	return setupListTest(t, map[string]string{
+		"001-parent/SPEC.md": `---
+status: approved
+assignee: Alice Example
+author: Bob Builder
+creation_date: 2026-01-02
+approved_by: Carol Chief
+approval_date: 2026-01-05
+depends_on: [2]
+---
+
+# Parent Spec
+
+See [Other](/specs/002-other/SPEC.md) and [Gone](/specs/009-gone/SPEC.md).
+
+## Goals
+
+### Stretch Goals
+`,
+		"001-parent/PLAN.md":           "---\nstatus: draft\n---\n\n# Parent Plan\n\n### PR 1: Parser\n\n- [x] Parse\n- [x] Test\n\n### PR 2: Output\n\n- [ ] Print\n",
+		"001-parent/001-child/SPEC.md": "---\nstatus: draft\n---\n\n# Child Spec\n\nBack to [parent](/specs/001-parent/SPEC.md).\n",
+		"002-other/SPEC.md":            "---\nstatus: completed\n---\n\n# Other Spec\n",
+	})
+}
+
+func TestShowCommand_TextOutput(t *testing.T) {
+	tmpDir := setupShowTest(t)
+
+	output, err := execShow(t, tmpDir, "text", "1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, want := range []string{
+		"Ref:            1\n",
+		"Title:          Parent Spec\n",
+		"Status:         approved\n",
+		"Assignee:       Alice Example\n",
+		"Approved:       2026-01-05 by Carol Chief\n",
+		"Plan:           yes\n",
+		"Depends on:     2\n",
+		"Links:          2 outbound, 1 inbound (1 not found)\n",
+		"Children (1):\n  1.1  Child Spec (draft)\n",
+		"Sections (2):\n  Goals\n    Stretch Goals\n",
+		"Progress:       2/3 tasks done (66%)\n",
+		"Tasks (2/3):\n  PLAN.md           2/3\n      PR 1: Parser  2/2\n      PR 2: Output  0/1\n",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected %q in output, got:\n%s", want, output)
+		}
+	}
+	if strings.Contains(output, "Parent:") {
+		t.Errorf("expected no parent row for a top-level spec, got:\n%s", output)
+	}
+}
+
+func TestShowCommand_JSONOutput(t *testing.T) {
+	tmpDir := setupShowTest(t)
+
+	output, err := execShow(t, tmpDir, "json", "1.1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var result map[string]any
+	if err := json.Unmarshal([]byte(output), &result); err != nil {
+		t.Fatalf("failed to parse JSON: %v\noutput: %s", err, output)
+	}
+	if len(result) != 29 {
+		t.Errorf("expected stable twenty-nine-field schema, got %v", result)
+	}
+	parent, ok := result["parent"].(map[string]any)
+	if !ok || parent["ref"] != "1" || parent["name"] != "Parent Spec" {
+		t.Errorf("expected parent 1, got %v", result["parent"])
+	}
+	if children, ok := result["children"].([]any); !ok || len(children) != 0 {
+		t.Errorf("expected empty children array, got %v", result["children"])
+	}
+	progress, ok := result["progress"].(map[string]any)
+	if !ok || progress["total"] != float64(0) || progress["plan"] == nil || progress["spec"] == nil {
+		t.Errorf("expected empty progress breakdown, got %v", result["progress"])
+	}
+	links, ok := result["links"].(map[string]any)
+	if !ok || links["outbound"] != float64(1) || links["inbound"] != float64(0) {
+		t.Errorf("expected one outbound link, got %v", result["links"])
+	}
+}
+
+func TestShowCommand_NotFound(t *testing.T) {
+	tmpDir := setupShowTest(t)
+
+	if _, err := execShow(t, tmpDir, "text", "7"); err == nil || !strings.Contains(err.Error(), "spec not found") {
+		t.Errorf("expected spec not found error, got %v", err)
+	}
+}
@@ -0,0 +1,183 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	gitpkg "github.com/specture-system/specture/internal/git"
+	specpkg "github.com/specture-system/specture/internal/spec"
+	"github.com/spf13/cobra"
+)
+
+var statusFormatFlag string
+var statusDryRunFlag bool
+
+var statusCmd = &cobra.Command{
+	Use:   "status <ref> <new-status>",
+	Args:  cobra.ExactArgs(2),
+	Short: "Change a spec's status",
+	Long: `Change a spec's status by rewriting only the status field in its frontmatter.
+
+Key order, comments, and formatting of the rest of the frontmatter are
+preserved. Moving a spec to approved also sets approved_by from your git
+user.name and approval_date to today.
+
+The new status must be one of the project's statuses, and when .specture.yaml
+defines a workflow, the change must be an allowed transition.
+
+Examples:
+  specture status 4 approved
+  specture status 4.2 in-progress
+  specture status 4 completed --dry-run
+  specture status 4 approved -f json`,
+	RunE: runStatus,
+}
+
+func init() {
+	statusCmd.Flags().StringVarP(&statusFormatFlag, "format", "f", "text", "Output format: text or json")
+	statusCmd.Flags().BoolVar(&statusDryRunFlag, "dry-run", false, "Preview the change without modifying files")
+}
+
+// statusFieldChange is a frontmatter field rewritten by the status command.
+type statusFieldChange struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// statusJSONOutput is the JSON output of the status command.
+type statusJSONOutput struct {
+	Ref     string              `json:"ref"`
+	Name    string              `json:"name"`
+	Path    string              `json:"path"`
+	From    string              `json:"from"`
+	To      string              `json:"to"`
+	Changes []statusFieldChange `json:"changes"`
+	DryRun  bool                `json:"dry_run"`
+}
+
+func runStatus(cmd *cobra.Command, args []string) error {
+	format, _ := cmd.Flags().GetString("format")
+	if format != "text" && format != "json" {
+		return fmt.Errorf("invalid format: %s (must be 'text' or 'json')", format)
+	}
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	newStatus := strings.TrimSpace(args[1])
+
+	specsDir, err := resolveSpecsDir()
+	if err != nil {
+		return err
+	}
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	tree, err := specpkg.BuildTree(specsDir)
+	if err != nil {
+		return err
+	}
+	node, err := tree.Resolve(args[0])
+	if err != nil {
+		return err
+	}
+	info := node.Spec
+
+	if !slices.Contains(cfg.Statuses, newStatus) {
+		return fmt.Errorf("invalid status %q (must be one of: %s)", newStatus, strings.Join(cfg.Statuses, ", "))
+	}
+	workflow := cfg.StatusWorkflow()
+	if !workflow.Allows(info.Status, newStatus) {
+		allowed := "none"
+		if next := workflow.Next(info.Status); len(next) > 0 {
+			allowed = strings.Join(next, ", ")
+		}
+		return fmt.Errorf("spec %s cannot move from %s to %s (allowed: %s)", info.FullRef, info.Status, newStatus, allowed)
+	}
+
+	changes := []statusFieldChange{{Field: "status", From: info.Status, To: newStatus}}
+	if newStatus == "approved" && info.Status != "approved" {
+		approver, err := gitpkg.GetAuthor(filepath.Dir(node.FilePath))
+		if err != nil || approver == "" {
+			return fmt.Errorf("failed to determine approver: set git user.name")
+		}
+		changes = append(changes,
+			statusFieldChange{Field: "approved_by", From: info.ApprovedBy, To: approver},
+			statusFieldChange{Field: "approval_date", From: info.ApprovalDate, To: time.Now().Format("2006-01-02")},
+		)
+	}
+	changes = slices.DeleteFunc(changes, func(change statusFieldChange) bool {
+		return change.From == change.To
+	})
+
+	if len(changes) > 0 && !dryRun {
+		if err := writeFrontmatterChanges(node.FilePath, changes); err != nil {
+			return err
+		}
+	}
+
+	if format == "json" {
+		data, err := json.MarshalIndent(statusJSONOutput{
+			Ref:     info.FullRef,
+			Name:    info.Name,
+			Path:    info.Path,
+			From:    info.Status,
+			To:      newStatus,
+			Changes: changes,
+			DryRun:  dryRun,
+		}, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		cmd.Println(string(data))
+		return nil
+	}
+
+	cmd.Printf("Spec %s: %s\n", info.FullRef, info.Name)
+	if len(changes) == 0 {
+		cmd.Printf("Status is already %s; nothing to change.\n", newStatus)
+		return nil
+	}
+	for _, change := range changes {
+		from := change.From
+		if from == "" {
+			from = "(unset)"
+		}
+		cmd.Printf("  %s: %s → %s\n", change.Field, from, change.To)
+	}
+	if dryRun {
+		cmd.Println("\n[dry-run] No changes made")
+	}
+	return nil
+}
+
+// writeFrontmatterChanges applies field changes to the spec file at path.
+func writeFrontmatterChanges(path string, changes []statusFieldChange) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read spec: %w", err)
+	}
+
+	fields := make([]specpkg.FrontmatterField, 0, len(changes))
+	for _, change := range changes {
+		fields = append(fields, specpkg.FrontmatterField{Key: change.Field, Value: change.To})
+	}
+	updated, err := specpkg.SetFrontmatterFields(content, fields)
+	if err != nil {
+		return fmt.Errorf("failed to update %s: %w", filepath.Base(path), err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("failed to stat spec: %w", err)
+	}
+	if err := os.WriteFile(path, updated, info.Mode().Perm()); err != nil {
+		return fmt.Errorf("failed to write spec: %w", err)
+	}
+	return nil
+}
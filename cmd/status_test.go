@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/specture-system/specture/internal/testhelpers"
+)
+
+// Helper to run the status command and return the output and error.
+func execStatus(t *testing.T, tmpDir string, flags map[string]string, args ...string) (string, error) {
+	t.Helper()
+
+	originalWd, _ := os.Getwd()
+	t.Cleanup(func() {
+		os.Chdir(originalWd)
+		statusCmd.Flags().Set("format", "text")
+		statusCmd.Flags().Set("dry-run", "false")
+	})
+	os.Chdir(tmpDir)
+
+	out := &bytes.Buffer{}
+	cmd := statusCmd
+	cmd.SetOut(out)
+	cmd.SetErr(out)
+	for name, value := range flags {
+		cmd.Flags().Set(name, value)
+	}
+
+	err := runStatus(cmd, args)
+	return out.String(), err
+}
+
+func TestStatusCommand_ApproveStampsApproval(t *testing.T) {
+	tmpDir := setupListTest(t, map[string]string{
+		"001-feature/SPEC.md": "---\n# keep me\nstatus: draft # current\nauthor: Alice\n---\n\n# Feature\n",
+	})
+	testhelpers.InitGitRepo(t, tmpDir)
+
+	output, err := execStatus(t, tmpDir, nil, "1", "approved")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(output, "status: draft → approved") {
+		t.Errorf("expected status change in output, got:\n%s", output)
+	}
+
+	content, _ := os.ReadFile(filepath.Join(tmpDir, "specs", "001-feature", "SPEC.md"))
+	today := time.Now().Format("2006-01-02")
+	want := "---\n# keep me\nstatus: approved # current\napproved_by: Test User\napproval_date: " + today + "\nauthor: Alice\n---\n\n# Feature\n"
+	if string(content) != want {
+		t.Errorf("unexpected spec content:\n%s\nwant:\n%s", content, want)
+	}
+}
+
+func TestStatusCommand_DryRunJSON(t *testing.T) {
+	original := "---\nstatus: approved\n---\n\n# Feature\n"
+	tmpDir := setupListTest(t, map[string]string{
+		"001-feature/SPEC.md": original,
+	})
+
+	output, err := execStatus(t, tmpDir, map[string]string{"dry-run": "true", "format": "json"}, "1", "in-progress")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var result statusJSONOutput
+	if err := json.Unmarshal([]byte(output), &result); err != nil {
+		t.Fatalf("failed to parse JSON: %v\noutput: %s", err, output)
+	}
+	if result.From != "approved" || result.To != "in-progress" || !result.DryRun || len(result.Changes) != 1 {
+		t.Errorf("unexpected JSON output: %+v", result)
+	}
+
+	content, _ := os.ReadFile(filepath.Join(tmpDir, "specs", "001-feature", "SPEC.md"))
+	if string(content) != original {
+		t.Errorf("expected dry run to leave the spec unchanged, got:\n%s", content)
+	}
+}
+
+func TestStatusCommand_RejectsInvalidChanges(t *testing.T) {
+	tmpDir := setupListTest(t, map[string]string{
+		"001-feature/SPEC.md": "---\nstatus: draft\n---\n\n# Feature\n",
+	})
+	config := "version: 1\nworkflow:\n  transitions:\n    draft: [approved, rejected]\n"
+	if err := os.WriteFile(filepath.Join(tmpDir, ".specture.yaml"), []byte(config), 0644); err != nil {
+		t.Fatalf("failed to write config: %v", err)
+	}
+
+	if _, err := execStatus(t, tmpDir, nil, "1", "shipped"); err == nil || !strings.Contains(err.Error(), `invalid status "shipped"`) {
+		t.Errorf("expected invalid status error, got %v", err)
+	}
+	_, err := execStatus(t, tmpDir, nil, "1", "completed")
+	if err == nil || !strings.Contains(err.Error(), "cannot move from draft to completed (allowed: approved, rejected)") {
+		t.Errorf("expected workflow error, got %v", err)
+	}
+}
@@ -0,0 +1,170 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	tpl "text/template"
+
+	specpkg "github.com/specture-system/specture/internal/spec"
+	"github.com/specture-system/specture/internal/template"
+	"github.com/spf13/cobra"
+)
+
+// templateFormat is the format that renders each spec with a Go template
+// given by --template or --template-file.
+const templateFormat = "template"
+
+// templateHelp documents the data and functions available to --template. It
+// is shared by the help of every command that accepts templates.
+const templateHelp = `Use --format template with --template '<go template>' or --template-file
+<path> to print each spec in a custom shape. In --template, \t and \n stand
+for a tab and a newline outside {{ }} actions; string literals inside
+actions use Go escapes. A newline is added after each spec unless the
+template ends with one.
+
+Templates see the same data as show -f json, using Go field names:
+  .Ref .Name .Status .Path .Author .CreationDate .ApprovedBy .ApprovalDate
+  .Assignee (comma-joined) .Assignees .Labels .Priority .Milestone
+  .DependsOn .Blocks .Supersedes .SupersededBy (lists of refs)
+  .Superseded .HasPlan (booleans)
+  .Parent (nil for top-level specs) and .Children, each with .Ref .Name .Status .Path
+  .Sections (each with .Level .Title)
+  .Links.Outbound .Links.Inbound .Links.Broken
+  .Progress.Done .Progress.Total .Progress.Percent, also under .Progress.Spec
+  and .Progress.Plan
+  .CreatedAt .CreatedBy .UpdatedAt .UpdatedBy (first and latest git commit)
+  .Extra (other frontmatter keys, as in {{index .Extra "team"}})
+
+Besides the built-in Go template functions, templates can use:
+  join SEP LIST    {{.Labels | join ","}}
+  upper, lower, trim
+  pad WIDTH S      left-align S in WIDTH characters: {{.Ref | pad 6}}
+  padLeft WIDTH S  right-align S in WIDTH characters
+  relpath PATH     PATH relative to the current directory: {{relpath .Path}}`
+
+// templateEscapes expands the escapes allowed in --template.
+var templateEscapes = strings.NewReplacer(`\t`, "\t", `\n`, "\n", `\\`, `\`)
+
+// expandTemplateEscapes expands templateEscapes in the text between actions.
+// Actions are kept as written, since their string literals are unquoted by
+// the template parser.
+func expandTemplateEscapes(text string) string {
+	var b strings.Builder
+	for {
+		start := strings.Index(text, "{{")
+		if start < 0 {
+			b.WriteString(templateEscapes.Replace(text))
+			return b.String()
+		}
+		end := actionEnd(text, start+2)
+		b.WriteString(templateEscapes.Replace(text[:start]))
+		b.WriteString(text[start:end])
+		text = text[end:]
+	}
+}
+
+// actionEnd returns the offset just past the "}}" that closes the action
+// whose body starts at i, skipping quoted strings and comments, or
+// len(text) when the action is not closed.
+func actionEnd(text string, i int) int {
+	for i < len(text) {
+		if strings.HasPrefix(text[i:], "/*") {
+			end := strings.Index(text[i+2:], "*/")
+			if end < 0 {
+				return len(text)
+			}
+			i += end + 4
+			continue
+		}
+		switch quote := text[i]; quote {
+		case '"', '\'', '`':
+			for i++; i < len(text) && text[i] != quote; i++ {
+				if text[i] == '\\' && quote != '`' {
+					i++
+				}
+			}
+		case '}':
+			if strings.HasPrefix(text[i:], "}}") {
+				return i + 2
+			}
+		}
+		i++
+	}
+	return len(text)
+}
+
+// addTemplateFlags adds --template and --template-file to cmd.
+func addTemplateFlags(cmd *cobra.Command, templateFlag, templateFileFlag *string) {
+	cmd.Flags().StringVar(templateFlag, "template", "", `Go template for --format template, such as '{{.Ref}}\t{{.Status}}'`)
+	cmd.Flags().StringVar(templateFileFlag, "template-file", "", "File holding the Go template for --format template")
+	cmd.MarkFlagsMutuallyExclusive("template", "template-file")
+}
+
+// resolveFormat returns the --format value, defaulting to template when a
+// template is given without --format. Templates with any other format are
+// rejected, as is the template format without a template.
+func resolveFormat(cmd *cobra.Command) (string, error) {
+	name, _ := cmd.Flags().GetString("format")
+	hasTemplate := cmd.Flags().Changed("template") || cmd.Flags().Changed("template-file")
+	switch {
+	case hasTemplate && !cmd.Flags().Changed("format"):
+		return templateFormat, nil
+	case hasTemplate && name != templateFormat:
+		return "", fmt.Errorf("--template and --template-file require --format template, not %s", name)
+	case !hasTemplate && name == templateFormat:
+		return "", fmt.Errorf("--format template requires --template or --template-file")
+	}
+	return name, nil
+}
+
+// loadOutputTemplate parses the template from --template or --template-file.
+// Spec paths given to relpath are taken relative to the project root that
+// holds tree.
+func loadOutputTemplate(cmd *cobra.Command, tree *specpkg.Tree) (*tpl.Template, error) {
+	text, _ := cmd.Flags().GetString("template")
+	text = expandTemplateEscapes(text)
+	if path, _ := cmd.Flags().GetString("template-file"); path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read template file: %w", err)
+		}
+		text = string(data)
+	}
+
+	projectRoot := filepath.Dir(tree.SpecsDir)
+	return template.Parse(text, tpl.FuncMap{
+		"relpath": func(path string) string {
+			if !filepath.IsAbs(path) {
+				path = filepath.Join(projectRoot, path)
+			}
+			cwd, err := os.Getwd()
+			if err != nil {
+				return path
+			}
+			rel, err := filepath.Rel(cwd, path)
+			if err != nil {
+				return path
+			}
+			return rel
+		},
+	})
+}
+
+// writeTemplate renders t once per node, ending each rendering with a
+// newline.
+func writeTemplate(cmd *cobra.Command, t *tpl.Template, tree *specpkg.Tree, nodes []*specpkg.Node) error {
+	inbound := tree.InboundCounts()
+	for _, node := range nodes {
+		out, err := template.Execute(t, newShowJSONOutput(tree, node, inbound[node]))
+		if err != nil {
+			return fmt.Errorf("spec %s: %w", node.Spec.FullRef, err)
+		}
+		if !strings.HasSuffix(out, "\n") {
+			out += "\n"
+		}
+		cmd.Print(out)
+	}
+	return nil
+}
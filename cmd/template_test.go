@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+// resetChanged clears the Changed state that Set leaves on the named flags.
+func resetChanged(cmd *cobra.Command, names ...string) {
+	for _, name := range names {
+		if f := cmd.Flags().Lookup(name); f != nil {
+			f.Changed = false
+		}
+	}
+}
+
+func setupTemplateTest(t *testing.T) string {
+	t.Helper()
+	return setupListTest(t, map[string]string{
+		"001-first/SPEC.md":           "---\nstatus: draft\nlabels: [cli, docs]\nteam: core\n---\n\n# First Feature\n\n- [x] One\n- [ ] Two\n",
+		"001-first/001-child/SPEC.md": "---\nstatus: approved\n---\n\n# Child Feature\n",
+		"002-second/SPEC.md":          "---\nstatus: approved\n---\n\n# Second Feature\n",
+	})
+}
+
+func TestListCommand_Template(t *testing.T) {
+	tmpDir := setupTemplateTest(t)
+
+	output, err := execList(t, tmpDir, map[string]string{
+		"template": `{{.Ref | pad 4}}{{.Status | upper}}\t{{.Labels | join ","}}`,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "1   DRAFT\tcli,docs\n1.1 APPROVED\t\n2   APPROVED\t\n"
+	if output != want {
+		t.Errorf("unexpected output:\n%q\nwant:\n%q", output, want)
+	}
+}
+
+func TestListCommand_TemplateEscapesOutsideActions(t *testing.T) {
+	tmpDir := setupTemplateTest(t)
+
+	output, err := execList(t, tmpDir, map[string]string{
+		"template": `{{printf "%s\n" .Ref}}\t{{/* it's "}}" */}}{{.Status}}\n`,
+		"status":   "draft",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "1\n\tdraft\n"
+	if output != want {
+		t.Errorf("unexpected output:\n%q\nwant:\n%q", output, want)
+	}
+}
+
+func TestListCommand_TemplateFile(t *testing.T) {
+	tmpDir := setupTemplateTest(t)
+	templatePath := filepath.Join(tmpDir, "specs.tmpl")
+	content := "{{.Ref}} {{.Name}} {{.Progress.Percent}}% {{index .Extra \"team\"}}\n{{with .Parent}}  parent {{.Ref}}\n{{end}}"
+	if err := os.WriteFile(templatePath, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	output, err := execList(t, tmpDir, map[string]string{
+		"format":        "template",
+		"template-file": templatePath,
+		"parent":        "1",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "1.1 Child Feature 0% <no value>\n  parent 1\n"
+	if output != want {
+		t.Errorf("unexpected output:\n%q\nwant:\n%q", output, want)
+	}
+}
+
+func TestListCommand_TemplateErrors(t *testing.T) {
+	tmpDir := setupTemplateTest(t)
+
+	tests := []struct {
+		name  string
+		flags map[string]string
+		want  string
+	}{
+		{"template with another format", map[string]string{"format": "json", "template": "{{.Ref}}"}, "require --format template, not json"},
+		{"template format without template", map[string]string{"format": "template"}, "requires --template or --template-file"},
+		{"parse error", map[string]string{"template": "{{.Ref"}, "failed to parse template"},
+		{"execute error", map[string]string{"template": "{{.Missing}}"}, "spec 1: failed to execute template"},
+		{"missing file", map[string]string{"template-file": "nope.tmpl"}, "failed to read template file"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := execList(t, tmpDir, tt.flags)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestShowCommand_Template(t *testing.T) {
+	tmpDir := setupTemplateTest(t)
+
+	originalWd, _ := os.Getwd()
+	t.Cleanup(func() {
+		os.Chdir(originalWd)
+		showCmd.Flags().Set("template", "")
+		resetChanged(showCmd, "template")
+	})
+	if err := os.Chdir(filepath.Join(tmpDir, "specs")); err != nil {
+		t.Fatal(err)
+	}
+
+	out := &bytes.Buffer{}
+	showCmd.SetOut(out)
+	showCmd.Flags().Set("template", `{{.Name}}: {{relpath .Path}}{{range .Children}} [{{.Ref}}]{{end}}`)
+	if err := runShow(showCmd, []string{"1"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "First Feature: " + filepath.Join("001-first", "SPEC.md") + " [1.1]\n"
+	if out.String() != want {
+		t.Errorf("unexpected output:\n%q\nwant:\n%q", out.String(), want)
+	}
+}
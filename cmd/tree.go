@@ -0,0 +1,173 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/specture-system/specture/internal/format"
+	specpkg "github.com/specture-system/specture/internal/spec"
+	"github.com/spf13/cobra"
+)
+
+// treeFormat is the list format that renders the spec hierarchy. It is not
+// a registered formatter because it needs the tree rather than a flat table.
+const treeFormat = "tree"
+
+var treeCmd = &cobra.Command{
+	Use:   "tree",
+	Args:  cobra.NoArgs,
+	Short: "Show the spec hierarchy as a tree",
+	Long: `Show the spec hierarchy as a tree, the same as specture list -f tree.
+
+Each line shows a status marker, the ref, the title, and the status. Markers:
+○ draft, ◇ approved, ◐ in-progress, ● completed, ✗ rejected, and · for other
+statuses.
+
+Filters work like specture list: completed and superseded specs are hidden by
+default, and --status, --assignee, --label, --milestone, --where,
+--updated-since, --stale, --ready, and --superseded choose which specs match.
+Ancestors of matching specs are always shown so each match keeps its place in
+the hierarchy.
+
+A completed spec whose shown descendants are all completed is collapsed to a
+single line noting how many nested specs it hides. Use --expand to show them.
+
+Use --parent to show the subtree under one spec, --depth to limit how many
+levels are shown below it, and --sort and --reverse to order siblings.
+
+Examples:
+  specture tree
+  specture tree --status all
+  specture tree --parent 4 --depth 1
+  specture tree --label cli --status all --expand`,
+	RunE: runTree,
+}
+
+func init() {
+	addListFlags(treeCmd)
+}
+
+// statusMarkers are the markers shown before each spec in tree output.
+var statusMarkers = map[string]string{
+	"draft":       "○",
+	"approved":    "◇",
+	"in-progress": "◐",
+	"completed":   "●",
+	"rejected":    "✗",
+}
+
+// runTree prints the specs matching the list filters as a tree. It serves
+// both specture tree and specture list -f tree, which share flag names.
+func runTree(cmd *cobra.Command, args []string) error {
+	tree, parent, specs, err := listSpecs(cmd, false)
+	if err != nil {
+		return err
+	}
+	if len(specs) == 0 {
+		cmd.Println("No specs found")
+		return nil
+	}
+
+	// Show the matching specs and every ancestor up to the scope root.
+	shown := make(map[*specpkg.Node]bool, len(specs))
+	for _, spec := range specs {
+		for node := tree.NodeForSpec(spec); node != nil && !shown[node]; node = node.Parent {
+			shown[node] = true
+		}
+	}
+
+	roots := tree.Roots
+	if parent != nil {
+		roots = []*specpkg.Node{parent}
+	}
+	builder := treeBuilder{cmd: cmd, tree: tree, shown: shown}
+	builder.expand, _ = cmd.Flags().GetBool("expand")
+	lines, err := builder.build(roots)
+	if err != nil {
+		return err
+	}
+	return format.WriteTree(cmd.OutOrStdout(), lines)
+}
+
+// treeBuilder converts shown spec nodes into tree lines.
+type treeBuilder struct {
+	cmd    *cobra.Command
+	tree   *specpkg.Tree
+	shown  map[*specpkg.Node]bool
+	expand bool
+}
+
+// build returns the lines for the shown nodes among siblings, in --sort
+// order, with their shown descendants nested below them.
+func (b treeBuilder) build(siblings []*specpkg.Node) ([]*format.TreeNode, error) {
+	var nodes []*specpkg.Node
+	for _, node := range siblings {
+		if b.shown[node] {
+			nodes = append(nodes, node)
+		}
+	}
+	specs := make([]*specpkg.SpecInfo, len(nodes))
+	for i, node := range nodes {
+		specs[i] = node.Spec
+	}
+	if err := sortSpecs(b.cmd, specs); err != nil {
+		return nil, err
+	}
+
+	lines := make([]*format.TreeNode, 0, len(specs))
+	for _, spec := range specs {
+		node := b.tree.NodeForSpec(spec)
+		status := spec.Status
+		if isSuperseded(b.tree, spec) {
+			status += ", superseded"
+		}
+
+		if hidden := b.collapsed(node); hidden > 0 {
+			lines = append(lines, &format.TreeNode{Text: treeLineText(spec, fmt.Sprintf("%s; %s collapsed", status, pluralize(hidden, "nested spec")))})
+			continue
+		}
+		children, err := b.build(node.Children)
+		if err != nil {
+			return nil, err
+		}
+		lines = append(lines, &format.TreeNode{Text: treeLineText(spec, status), Children: children})
+	}
+	return lines, nil
+}
+
+// collapsed returns the number of shown descendants hidden under node: all
+// of them when node and every shown descendant are completed, otherwise 0.
+func (b treeBuilder) collapsed(node *specpkg.Node) int {
+	if b.expand || node.Spec.Status != "completed" {
+		return 0
+	}
+	hidden := 0
+	for _, descendant := range node.Descendants() {
+		if !b.shown[descendant] {
+			continue
+		}
+		if descendant.Spec.Status != "completed" {
+			return 0
+		}
+		hidden++
+	}
+	return hidden
+}
+
+// treeLineText formats a spec's tree line as marker, ref, title, and status.
+func treeLineText(spec *specpkg.SpecInfo, status string) string {
+	marker, ok := statusMarkers[spec.Status]
+	if !ok {
+		marker = "·"
+	}
+	return strings.Join(slices.DeleteFunc([]string{marker, spec.FullRef, spec.Name}, func(s string) bool { return s == "" }), " ") + " (" + status + ")"
+}
+
+// pluralize formats count with noun, adding "s" unless count is 1.
+func pluralize(count int, noun string) string {
+	if count == 1 {
+		return fmt.Sprintf("1 %s", noun)
+	}
+	return fmt.Sprintf("%d %ss", count, noun)
+}
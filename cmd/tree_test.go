@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+// Helper to run the tree command and return the output and error.
+func execTree(t *testing.T, tmpDir string, flags map[string]string) (string, error) {
+	t.Helper()
+
+	originalWd, _ := os.Getwd()
+	t.Cleanup(func() {
+		os.Chdir(originalWd)
+		resetFlags(treeCmd)
+	})
+	os.Chdir(tmpDir)
+
+	out := &bytes.Buffer{}
+	treeCmd.SetOut(out)
+	treeCmd.SetErr(out)
+	for k, v := range flags {
+		treeCmd.Flags().Set(k, v)
+	}
+
+	err := runTree(treeCmd, []string{})
+	return out.String(), err
+}
+
+func setupTreeTest(t *testing.T) string {
+	t.Helper()
+	return setupListTest(t, map[string]string{
+		"001-parent/SPEC.md":                "---\nstatus: in-progress\n---\n\n# Parent\n",
+		"001-parent/001-child-a/SPEC.md":    "---\nstatus: completed\n---\n\n# Child A\n",
+		"001-parent/002-child-b/SPEC.md":    "---\nstatus: draft\nlabels: [cli]\n---\n\n# Child B\n",
+		"002-done/SPEC.md":                  "---\nstatus: completed\n---\n\n# Done\n",
+		"002-done/001-done-child/SPEC.md":   "---\nstatus: completed\n---\n\n# Done Child\n",
+		"003-other/SPEC.md":                 "---\nstatus: approved\n---\n\n# Other\n",
+		"003-other/001-other-child/SPEC.md": "---\nstatus: deferred\n---\n\n# Other Child\n",
+	})
+}
+
+func TestTreeCommand(t *testing.T) {
+	tmpDir := setupTreeTest(t)
+
+	tests := []struct {
+		name  string
+		flags map[string]string
+		want  string
+	}{
+		{"default hides completed", map[string]string{},
+			"◐ 1 Parent (in-progress)\n" +
+				"└── ○ 1.2 Child B (draft)\n" +
+				"◇ 3 Other (approved)\n"},
+		{"all statuses collapse completed subtrees", map[string]string{"status": "all"},
+			"◐ 1 Parent (in-progress)\n" +
+				"├── ● 1.1 Child A (completed)\n" +
+				"└── ○ 1.2 Child B (draft)\n" +
+				"● 2 Done (completed; 1 nested spec collapsed)\n" +
+				"◇ 3 Other (approved)\n" +
+				"└── · 3.1 Other Child (deferred)\n"},
+		{"expand", map[string]string{"status": "all", "expand": "true"},
+			"◐ 1 Parent (in-progress)\n" +
+				"├── ● 1.1 Child A (completed)\n" +
+				"└── ○ 1.2 Child B (draft)\n" +
+				"● 2 Done (completed)\n" +
+				"└── ● 2.1 Done Child (completed)\n" +
+				"◇ 3 Other (approved)\n" +
+				"└── · 3.1 Other Child (deferred)\n"},
+		{"ancestors of matches stay visible", map[string]string{"status": "deferred"},
+			"◇ 3 Other (approved)\n" +
+				"└── · 3.1 Other Child (deferred)\n"},
+		{"parent", map[string]string{"parent": "1", "status": "all"},
+			"◐ 1 Parent (in-progress)\n" +
+				"├── ● 1.1 Child A (completed)\n" +
+				"└── ○ 1.2 Child B (draft)\n"},
+		{"depth", map[string]string{"depth": "1", "status": "all", "expand": "true"},
+			"◐ 1 Parent (in-progress)\n" +
+				"● 2 Done (completed)\n" +
+				"◇ 3 Other (approved)\n"},
+		{"reverse", map[string]string{"reverse": "true"},
+			"◇ 3 Other (approved)\n" +
+				"◐ 1 Parent (in-progress)\n" +
+				"└── ○ 1.2 Child B (draft)\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := execTree(t, tmpDir, tt.flags)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if output != tt.want {
+				t.Errorf("unexpected tree:\n%s\nwant:\n%s", output, tt.want)
+			}
+		})
+	}
+}
+
+func TestListCommand_TreeFormat(t *testing.T) {
+	tmpDir := setupTreeTest(t)
+
+	listCmd.Flags().Set("label", "cli")
+	output, err := execList(t, tmpDir, map[string]string{"format": "tree"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "◐ 1 Parent (in-progress)\n└── ○ 1.2 Child B (draft)\n"
+	if output != want {
+		t.Errorf("unexpected tree:\n%s\nwant:\n%s", output, want)
+	}
+
+	output, err = execList(t, tmpDir, map[string]string{"format": "tree", "status": "rejected"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if output != "No specs found\n" {
+		t.Errorf("expected no specs message, got %q", output)
+	}
+}
@@ -2,15 +2,21 @@ package cmd
 
 import (
 	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 
+	gitpkg "github.com/specture-system/specture/internal/git"
 	specpkg "github.com/specture-system/specture/internal/spec"
 	"github.com/specture-system/specture/internal/validate"
 	"github.com/spf13/cobra"
 )
 
 var specFlag string
+var validateBaseFlag string
+var validateFormatFlag string
 
 var validateCmd = &cobra.Command{
 	Use:     "validate",
@@ -18,13 +24,44 @@ var validateCmd = &cobra.Command{
 	Short:   "Validate specs",
 	Long: `Validate checks that specs follow the Specture System guidelines.
 
-It validates frontmatter, status, and descriptions.
+It validates frontmatter, status, and descriptions. Dependency fields
+(depends_on and blocks) must list refs of existing specs and must not form a
+cycle. Supersession fields (supersedes and superseded_by) must list existing
+specs and must be recorded on both sides of the relation. The assignee field
+must be a name or a list of names, labels must come from labels.allowed in
+.specture.yaml when that list is set, and priority must be one of the
+configured priorities.
+
+Allowed statuses, required frontmatter fields, and the severity of each rule
+(error, warning, or off) can be set in .specture.yaml. Warnings are reported
+but do not fail validation. Rules: read, spec-path, frontmatter,
+required-fields, status, title, numbered-headings, duplicate-ref, ref-fields,
+unknown-ref, dependency-cycle, supersession, status-transition, assignee,
+labels, priority.
+
+Use --base to compare each spec's status against its status at a git revision
+and flag changes the workflow in .specture.yaml does not allow, such as
+draft → completed. Specs are matched by ref, so a spec moved with specture
+rename keeps its base status. Specs added since the base must start in one
+of the workflow's initial statuses.
+
+Use -f to print machine-readable results for CI instead of text, where every
+finding carries the spec's path relative to the project root, its rule,
+severity, and message:
+  json    the results of each spec with summary counts
+  junit   a JUnit XML report with one test case per spec
+  sarif   a SARIF 2.1.0 log for code scanning
+  github  GitHub Actions annotations on the spec files
+The exit status is the same for every format.
 
 Examples:
   specture validate              # Validate all specs in the specs tree
   specture validate --spec 0     # Validate a specific spec by reference
   specture validate --spec 1.4   # Validate a nested spec by reference
-  specture validate -s 42        # Short form, validates a specific spec`,
+  specture validate -s 42        # Short form, validates a specific spec
+  specture validate --base origin/main  # Also check status transitions
+  specture validate -f github    # Annotate findings in a GitHub Actions run
+  specture validate -f junit > specture.xml`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		invalidCount, err := runValidate(cmd, args)
 		if err != nil {
@@ -42,73 +79,123 @@ Examples:
 
 func init() {
 	validateCmd.Flags().StringVarP(&specFlag, "spec", "s", "", "Spec reference to validate (e.g., 3 or 1.4.3)")
+	validateCmd.Flags().StringVar(&validateBaseFlag, "base", "", "Git revision to check status transitions against (e.g., origin/main)")
+	validateCmd.Flags().StringVarP(&validateFormatFlag, "format", "f", "text", "Output format: text, "+strings.Join(validate.ReportFormats, ", "))
 }
 
 // runValidate performs validation and returns the count of invalid specs.
 // Separated from the command for testability.
 func runValidate(cmd *cobra.Command, args []string) (invalidCount int, err error) {
-	// Get current working directory
-	cwd, err := os.Getwd()
+	specsDir, err := resolveSpecsDir()
 	if err != nil {
-		return 0, fmt.Errorf("failed to get current directory: %w", err)
+		return 0, err
 	}
 
-	specsDir := filepath.Join(cwd, "specs")
+	format, _ := cmd.Flags().GetString("format")
+	if format != "text" && !slices.Contains(validate.ReportFormats, format) {
+		return 0, fmt.Errorf("invalid format: %s (must be text, %s)", format, strings.Join(validate.ReportFormats, ", "))
+	}
 
 	// Get spec flag value
 	spec, _ := cmd.Flags().GetString("spec")
 
-	// Determine which specs to validate
-	var specPaths []string
+	// Cross-spec checks such as duplicate refs and dependencies need the
+	// whole tree, so every spec is parsed even when only one is requested.
+	specPaths, err := specpkg.FindAll(specsDir)
+	if err != nil {
+		return 0, err
+	}
 
-	if spec == "" {
-		// Validate all specs
-		paths, err := specpkg.FindAll(specsDir)
-		if err != nil {
-			return 0, err
-		}
-		specPaths = paths
-	} else {
+	var selectedPath string
+	if spec != "" {
 		// Resolve the requested reference to a single spec file.
-		path, err := specpkg.ResolvePath(specsDir, spec)
+		selectedPath, err = specpkg.ResolvePath(specsDir, spec)
 		if err != nil {
 			return 0, err
 		}
-		specPaths = append(specPaths, path)
+		if selectedPath, err = filepath.Abs(selectedPath); err != nil {
+			return 0, fmt.Errorf("failed to resolve spec path: %w", err)
+		}
+		// A PLAN.md selected by path stands in for its sibling SPEC.md so
+		// the directory is not counted twice.
+		specPaths = slices.DeleteFunc(specPaths, func(path string) bool {
+			return filepath.Dir(path) == filepath.Dir(selectedPath)
+		})
+		specPaths = append(specPaths, selectedPath)
 	}
 
 	if len(specPaths) == 0 {
+		if format != "text" {
+			return 0, writeValidateReport(cmd, format, specsDir, nil)
+		}
 		cmd.Println("No specs found to validate")
 		return 0, nil
 	}
 
 	// Parse all specs
 	var specs []*validate.Spec
-	var parseErrors []string
+	readErrors := make(map[string]error)
 	for _, path := range specPaths {
 		s, err := validate.ParseSpec(path)
 		if err != nil {
-			cmd.PrintErrf("Error reading %s: %v\n", filepath.Base(path), err)
-			parseErrors = append(parseErrors, path)
+			if selectedPath == "" || path == selectedPath {
+				readErrors[path] = err
+			}
 			continue
 		}
+		s.SpecsDir = specsDir
 		specs = append(specs, s)
 	}
 
+	cfg, err := loadConfig()
+	if err != nil {
+		return 0, err
+	}
+	opts, err := validate.NewOptions(cfg.Statuses, cfg.Frontmatter.Required, cfg.Validation.Rules)
+	if err != nil {
+		return 0, fmt.Errorf("invalid validation config: %w", err)
+	}
+	opts.Workflow = cfg.StatusWorkflow()
+	opts.Labels = cfg.Labels.Allowed
+	opts.Priorities = cfg.Priorities
+
+	base, _ := cmd.Flags().GetString("base")
+	if base != "" {
+		opts.BaseStatuses, err = baseStatuses(specsDir, base)
+		if err != nil {
+			return 0, err
+		}
+	}
+
 	// Validate all specs (includes cross-spec checks like duplicate refs)
-	results := validate.ValidateSpecs(specs)
+	results := validate.ValidateSpecsWithOptions(specs, opts)
+	if selectedPath != "" {
+		results = slices.DeleteFunc(results, func(result *validate.ValidationResult) bool {
+			return result.Path != selectedPath
+		})
+	}
+
+	// Unreadable specs are reported after the others, in path order.
+	for _, path := range slices.Sorted(maps.Keys(readErrors)) {
+		results = append(results, validate.ReadErrorResult(path, readErrors[path], opts))
+	}
 
 	var validCount int
 	for _, result := range results {
-		cmd.Print(validate.FormatValidationResult(result))
-
 		if result.IsValid() {
 			validCount++
 		} else {
 			invalidCount++
 		}
 	}
-	invalidCount += len(parseErrors)
+
+	if format != "text" {
+		return invalidCount, writeValidateReport(cmd, format, specsDir, results)
+	}
+
+	for _, result := range results {
+		cmd.Print(validate.FormatValidationResult(result))
+	}
 
 	// Print summary
 	total := validCount + invalidCount
@@ -116,3 +203,31 @@ func runValidate(cmd *cobra.Command, args []string) (invalidCount int, err error
 
 	return invalidCount, nil
 }
+
+// writeValidateReport prints results in a machine-readable format, with
+// paths relative to the project root that holds specsDir.
+func writeValidateReport(cmd *cobra.Command, format, specsDir string, results []*validate.ValidationResult) error {
+	root, err := filepath.Abs(filepath.Dir(specsDir))
+	if err != nil {
+		return fmt.Errorf("failed to resolve project root: %w", err)
+	}
+	return validate.WriteReport(cmd.OutOrStdout(), format, validate.Report{
+		Results:     results,
+		Root:        root,
+		ToolVersion: cmd.Root().Version,
+	})
+}
+
+// baseStatuses reads each spec's status as of the git revision base, keyed
+// by ref. The spec files at base are read in one pass over the git tree.
+func baseStatuses(specsDir, base string) (map[string]string, error) {
+	if err := gitpkg.VerifyRevision(specsDir, base); err != nil {
+		return nil, err
+	}
+
+	files, err := gitpkg.ReadFiles(specsDir, base, specpkg.IsSpecFilePath)
+	if err != nil {
+		return nil, err
+	}
+	return validate.BaseStatuses(files), nil
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/specture-system/specture/internal/spec"
+	"github.com/specture-system/specture/internal/testhelpers"
 )
 
 // Note: These tests intentionally do not use t.Parallel() because validateCmd is a
@@ -663,3 +664,264 @@ func TestResolveSpecPath_DottedRef(t *testing.T) {
 		t.Fatalf("expected child path %q, got %q", childPath, result)
 	}
 }
+
+func TestValidateCommand_CustomSpecsDir(t *testing.T) {
+	tmpDir := t.TempDir()
+	designDir := filepath.Join(tmpDir, "design")
+	files := map[string]string{
+		"001-base/SPEC.md":    "---\nstatus: draft\n---\n\n# Base\n",
+		"002-feature/SPEC.md": "---\nstatus: draft\ndepends_on: 1\n---\n\n# Feature\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(designDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("failed to create spec dir: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write spec: %v", err)
+		}
+	}
+
+	originalWd, _ := os.Getwd()
+	t.Cleanup(func() {
+		os.Chdir(originalWd)
+		specsDirFlag = ""
+	})
+	os.Chdir(t.TempDir())
+	specsDirFlag = designDir
+
+	out := &bytes.Buffer{}
+	cmd := validateCmd
+	cmd.SetOut(out)
+	cmd.SetErr(out)
+
+	invalidCount, err := runValidate(cmd, []string{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if invalidCount != 0 {
+		t.Errorf("expected specs outside a specs/ directory to validate, got:\n%s", out.String())
+	}
+	if !strings.Contains(out.String(), "2 of 2 specs valid") {
+		t.Errorf("expected summary in output, got: %s", out.String())
+	}
+}
+
+func TestValidateCommand_BaseStatusTransitions(t *testing.T) {
+	tmpDir := t.TempDir()
+	testhelpers.InitGitRepo(t, tmpDir)
+	testhelpers.WriteFile(t, tmpDir, ".specture.yaml", `version: 1
+workflow:
+  initial: [draft]
+  transitions:
+    draft: [approved, rejected]
+    approved: [in-progress, rejected]
+    in-progress: [completed]
+`)
+	jumped := testhelpers.WriteFile(t, tmpDir, "specs/001-jumped/SPEC.md", "---\nstatus: draft\n---\n\n# Jumped\n")
+	approved := testhelpers.WriteFile(t, tmpDir, "specs/002-approved/SPEC.md", "---\nstatus: draft\n---\n\n# Approved\n")
+	// A spec without a status is a draft.
+	statusless := testhelpers.WriteFile(t, tmpDir, "specs/004-statusless/SPEC.md", "---\nauthor: Alice\n---\n\n# Statusless\n")
+	for _, args := range [][]string{{"add", "."}, {"commit", "-m", "initial"}} {
+		if err := testhelpers.RunGitCommand(tmpDir, args); err != nil {
+			t.Fatalf("git %v failed: %v", args, err)
+		}
+	}
+	testhelpers.WriteFile(t, tmpDir, "specs/003-new/SPEC.md", "---\nstatus: approved\n---\n\n# New\n")
+	if err := os.WriteFile(jumped, []byte("---\nstatus: completed\n---\n\n# Jumped\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(approved, []byte("---\nstatus: approved\n---\n\n# Approved\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(statusless, []byte("---\nstatus: completed\n---\n\n# Statusless\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	originalWd, _ := os.Getwd()
+	t.Cleanup(func() {
+		os.Chdir(originalWd)
+		validateCmd.Flags().Set("base", "")
+	})
+	os.Chdir(tmpDir)
+
+	out := &bytes.Buffer{}
+	cmd := validateCmd
+	cmd.SetOut(out)
+	cmd.SetErr(out)
+	cmd.Flags().Set("base", "HEAD")
+
+	invalidCount, err := runValidate(cmd, []string{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	output := out.String()
+	if invalidCount != 3 {
+		t.Errorf("expected 3 invalid specs, got %d:\n%s", invalidCount, output)
+	}
+	if strings.Count(output, "illegal transition draft → completed") != 2 {
+		t.Errorf("expected the status-less base spec to count as a draft, got:\n%s", output)
+	}
+	for _, want := range []string{
+		"illegal transition draft → completed (allowed from draft: approved, rejected)",
+		`new specs must start as one of: draft (found "approved")`,
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("expected %q in output, got:\n%s", want, output)
+		}
+	}
+
+	cmd.Flags().Set("base", "no-such-revision")
+	if _, err := runValidate(cmd, []string{}); err == nil || !strings.Contains(err.Error(), "unknown git revision") {
+		t.Errorf("expected unknown revision error, got %v", err)
+	}
+}
+
+func TestValidateCommand_ReportFormats(t *testing.T) {
+	tmpDir := t.TempDir()
+	testhelpers.WriteFile(t, tmpDir, "specs/001-valid/SPEC.md", "---\nstatus: draft\n---\n\n# Valid\n")
+	testhelpers.WriteFile(t, tmpDir, "specs/002-invalid/SPEC.md", "---\nstatus: bogus\n---\n\n# Invalid\n")
+
+	originalWd, _ := os.Getwd()
+	t.Cleanup(func() {
+		os.Chdir(originalWd)
+		validateCmd.Flags().Set("format", "text")
+		resetChanged(validateCmd, "format")
+	})
+	os.Chdir(tmpDir)
+
+	tests := []struct {
+		format string
+		want   []string
+	}{
+		{"json", []string{`"invalid": 1`, `"path": "specs/002-invalid/SPEC.md"`, `"rule": "status"`, `"severity": "error"`}},
+		{"junit", []string{`<testsuites name="specture validate" tests="2" failures="1">`, `<testcase classname="specture.validate" name="specs/002-invalid/SPEC.md">`, `<failure type="status"`}},
+		{"sarif", []string{`"version": "2.1.0"`, `"ruleId": "status"`, `"uri": "specs/002-invalid/SPEC.md"`}},
+		{"github", []string{"::error file=specs/002-invalid/SPEC.md,title=specture status::status: ", "1 of 2 specs valid"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			cmd := validateCmd
+			cmd.SetOut(out)
+			cmd.SetErr(out)
+			cmd.Flags().Set("format", tt.format)
+
+			invalidCount, err := runValidate(cmd, []string{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if invalidCount != 1 {
+				t.Errorf("expected 1 invalid spec, got %d", invalidCount)
+			}
+			output := out.String()
+			if strings.Contains(output, "✗") {
+				t.Errorf("expected no text results in %s output, got:\n%s", tt.format, output)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(output, want) {
+					t.Errorf("expected %q in output, got:\n%s", want, output)
+				}
+			}
+		})
+	}
+
+	cmd := validateCmd
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.Flags().Set("format", "yaml")
+	if _, err := runValidate(cmd, []string{}); err == nil || !strings.Contains(err.Error(), "invalid format: yaml") {
+		t.Errorf("expected invalid format error, got %v", err)
+	}
+}
+
+func TestValidateCommand_ReportsUnreadableSpecs(t *testing.T) {
+	tmpDir := t.TempDir()
+	testhelpers.WriteFile(t, tmpDir, "specs/001-valid/SPEC.md", "---\nstatus: draft\n---\n\n# Valid\n")
+	if err := os.MkdirAll(filepath.Join(tmpDir, "specs", "002-broken"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	// A dangling symlink is found as a spec but cannot be read.
+	if err := os.Symlink("missing.md", filepath.Join(tmpDir, "specs", "002-broken", "SPEC.md")); err != nil {
+		t.Skipf("symlinks not supported: %v", err)
+	}
+
+	originalWd, _ := os.Getwd()
+	t.Cleanup(func() {
+		os.Chdir(originalWd)
+		validateCmd.Flags().Set("format", "text")
+		resetChanged(validateCmd, "format")
+	})
+	os.Chdir(tmpDir)
+
+	for _, tt := range []struct {
+		format string
+		want   []string
+	}{
+		{"text", []string{"specs/002-broken/SPEC.md", "failed to read file", "1 of 2 specs valid"}},
+		{"json", []string{`"invalid": 1`, `"path": "specs/002-broken/SPEC.md"`, `"rule": "read"`}},
+	} {
+		out := &bytes.Buffer{}
+		cmd := validateCmd
+		cmd.SetOut(out)
+		cmd.SetErr(out)
+		cmd.Flags().Set("format", tt.format)
+
+		invalidCount, err := runValidate(cmd, []string{})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tt.format, err)
+		}
+		if invalidCount != 1 {
+			t.Errorf("%s: expected 1 invalid spec, got %d", tt.format, invalidCount)
+		}
+		for _, want := range tt.want {
+			if !strings.Contains(out.String(), want) {
+				t.Errorf("%s: expected %q in output, got:\n%s", tt.format, want, out.String())
+			}
+		}
+	}
+}
+
+func TestValidateCommand_BaseStatusTransitionsFollowRenames(t *testing.T) {
+	tmpDir := t.TempDir()
+	testhelpers.InitGitRepo(t, tmpDir)
+	testhelpers.WriteFile(t, tmpDir, ".specture.yaml", `version: 1
+workflow:
+  initial: [draft]
+  transitions:
+    draft: [approved]
+`)
+	testhelpers.WriteFile(t, tmpDir, "specs/001-old-name/SPEC.md", "---\nstatus: draft\n---\n\n# Renamed\n")
+	for _, args := range [][]string{{"add", "."}, {"commit", "-m", "initial"}} {
+		if err := testhelpers.RunGitCommand(tmpDir, args); err != nil {
+			t.Fatalf("git %v failed: %v", args, err)
+		}
+	}
+	if err := os.Rename(filepath.Join(tmpDir, "specs/001-old-name"), filepath.Join(tmpDir, "specs/001-new-name")); err != nil {
+		t.Fatal(err)
+	}
+	testhelpers.WriteFile(t, tmpDir, "specs/001-new-name/SPEC.md", "---\nstatus: completed\n---\n\n# Renamed\n")
+
+	originalWd, _ := os.Getwd()
+	t.Cleanup(func() {
+		os.Chdir(originalWd)
+		validateCmd.Flags().Set("base", "")
+	})
+	os.Chdir(tmpDir)
+
+	out := &bytes.Buffer{}
+	cmd := validateCmd
+	cmd.SetOut(out)
+	cmd.SetErr(out)
+	cmd.Flags().Set("base", "HEAD")
+
+	if _, err := runValidate(cmd, []string{}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	output := out.String()
+	if !strings.Contains(output, "illegal transition draft → completed") {
+		t.Errorf("expected renamed spec to be checked against its base status, got:\n%s", output)
+	}
+	if strings.Contains(output, "new specs must start as") {
+		t.Errorf("expected renamed spec not to be treated as new, got:\n%s", output)
+	}
+}
@@ -4,13 +4,15 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
-	"path/filepath"
 	"strings"
 
 	specpkg "github.com/specture-system/specture/internal/spec"
 	"github.com/spf13/cobra"
 )
 
+var viewSectionFlag string
+var viewSectionsFlag bool
+
 var viewCmd = &cobra.Command{
 	Use:   "view <ref>",
 	Args:  cobra.ExactArgs(1),
@@ -20,23 +22,42 @@ var viewCmd = &cobra.Command{
 VISUAL is used when set, with EDITOR as a fallback. When neither is set, cat is
 used. Editor commands may include arguments, such as "code --wait" or "nvim -f".
 
+Use --section to print a single section instead of opening the editor. The
+section runs from the matching heading (case-insensitive) through its nested
+subsections, up to the next heading at the same or a higher level. Use
+--sections to list the headings that --section accepts.
+
 Examples:
   specture view 4
-  specture view 4.2`,
+  specture view 4.2
+  specture view 4 --sections
+  specture view 4 --section "Design Decisions"`,
 	RunE: runView,
 }
 
+func init() {
+	viewCmd.Flags().StringVar(&viewSectionFlag, "section", "", "Print only the section with this heading")
+	viewCmd.Flags().BoolVar(&viewSectionsFlag, "sections", false, "List the spec's section headings")
+	viewCmd.MarkFlagsMutuallyExclusive("section", "sections")
+}
+
 func runView(cmd *cobra.Command, args []string) error {
-	cwd, err := os.Getwd()
+	specsDir, err := resolveSpecsDir()
 	if err != nil {
-		return fmt.Errorf("failed to get current directory: %w", err)
+		return err
 	}
 
-	path, err := specpkg.ResolveRef(filepath.Join(cwd, "specs"), args[0])
+	path, err := specpkg.ResolveRef(specsDir, args[0])
 	if err != nil {
 		return err
 	}
 
+	section, _ := cmd.Flags().GetString("section")
+	listSections, _ := cmd.Flags().GetBool("sections")
+	if section != "" || listSections {
+		return printSections(cmd, path, section, listSections)
+	}
+
 	editor, err := configuredEditor()
 	if err != nil {
 		return err
@@ -56,6 +77,33 @@ func runView(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// printSections prints the spec at path's heading outline when listSections
+// is set, or else the markdown of the named section.
+func printSections(cmd *cobra.Command, path, section string, listSections bool) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read spec: %w", err)
+	}
+
+	if listSections {
+		headings := specpkg.Headings(content)
+		if len(headings) == 0 {
+			cmd.Println("No sections found")
+		}
+		for _, heading := range headings {
+			cmd.Printf("%s%s\n", strings.Repeat("  ", heading.Level-2), heading.Title)
+		}
+		return nil
+	}
+
+	markdown, err := specpkg.ExtractSection(content, section)
+	if err != nil {
+		return err
+	}
+	cmd.Print(string(markdown))
+	return nil
+}
+
 func configuredEditor() (string, error) {
 	if editor := os.Getenv("VISUAL"); strings.TrimSpace(editor) != "" {
 		return editor, nil
@@ -131,3 +131,42 @@ func writeViewEditor(t *testing.T) string {
 	}
 	return path
 }
+
+func TestViewCommand_Sections(t *testing.T) {
+	repoDir, _ := setupViewTest(t)
+	t.Setenv("VISUAL", "should-not-run")
+	specPath := filepath.Join(repoDir, "specs", "001-parent", "SPEC.md")
+	content := "---\nstatus: draft\n---\n\n# Parent\n\n## Goals\n\nShip it.\n\n### Stretch Goals\n\nMore.\n\n## Design Decisions\n\nUse AST.\n"
+	if err := os.WriteFile(specPath, []byte(content), 0o644); err != nil {
+		t.Fatalf("failed to write spec: %v", err)
+	}
+	t.Cleanup(func() {
+		viewCmd.Flags().Set("section", "")
+		viewCmd.Flags().Set("sections", "false")
+	})
+
+	viewCmd.Flags().Set("section", "goals")
+	output, err := execView(t, repoDir, "1", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "## Goals\n\nShip it.\n\n### Stretch Goals\n\nMore.\n"; output != want {
+		t.Errorf("expected section %q, got %q", want, output)
+	}
+
+	viewCmd.Flags().Set("section", "Non-Goals")
+	_, err = execView(t, repoDir, "1", "")
+	if err == nil || !strings.Contains(err.Error(), `section "Non-Goals" not found (available: Goals, Stretch Goals, Design Decisions)`) {
+		t.Errorf("expected missing section error, got %v", err)
+	}
+
+	viewCmd.Flags().Set("section", "")
+	viewCmd.Flags().Set("sections", "true")
+	output, err = execView(t, repoDir, "1", "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "Goals\n  Stretch Goals\nDesign Decisions\n"; output != want {
+		t.Errorf("expected outline %q, got %q", want, output)
+	}
+}
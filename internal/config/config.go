@@ -0,0 +1,290 @@
+// Package config loads the project configuration file, .specture.yaml.
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/specture-system/specture/internal/rules"
+	"github.com/specture-system/specture/internal/workflow"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// FileName is the name of the project configuration file. It lives in
+	// the project root, next to the specs directory.
+	FileName = ".specture.yaml"
+	// CurrentVersion is the newest configuration format this build reads.
+	CurrentVersion = 1
+)
+
+// Config is the effective project configuration: the values from
+// .specture.yaml merged over the defaults.
+type Config struct {
+	Version int `yaml:"version" json:"version"`
+	// Statuses lists the allowed spec status values.
+	Statuses []string `yaml:"statuses" json:"statuses"`
+	// Priorities lists the allowed priority values, highest first.
+	Priorities  []string          `yaml:"priorities" json:"priorities"`
+	List        ListConfig        `yaml:"list" json:"list"`
+	Numbering   NumberingConfig   `yaml:"numbering" json:"numbering"`
+	Frontmatter FrontmatterConfig `yaml:"frontmatter" json:"frontmatter"`
+	Templates   TemplatesConfig   `yaml:"templates" json:"templates"`
+	Validation  ValidationConfig  `yaml:"validation" json:"validation"`
+	Workflow    WorkflowConfig    `yaml:"workflow" json:"workflow"`
+	Labels      LabelsConfig      `yaml:"labels" json:"labels"`
+
+	// Path is the configuration file the values were loaded from, or empty
+	// when no file exists and the defaults are in effect.
+	Path string `yaml:"-" json:"-"`
+}
+
+// ListConfig controls the list command.
+type ListConfig struct {
+	// DefaultStatuses are shown when no --status filter is given.
+	DefaultStatuses []string `yaml:"default_statuses" json:"default_statuses"`
+}
+
+// NumberingConfig controls spec directory names.
+type NumberingConfig struct {
+	// Padding is the zero-padded width of the number in directory names,
+	// e.g. 3 for 001-name.
+	Padding int `yaml:"padding" json:"padding"`
+}
+
+// FrontmatterConfig controls spec frontmatter.
+type FrontmatterConfig struct {
+	// Required lists the frontmatter fields every spec must set.
+	Required []string `yaml:"required" json:"required"`
+}
+
+// TemplatesConfig points at custom templates for new files. Paths are
+// relative to the project root; empty paths use the built-in templates.
+type TemplatesConfig struct {
+	Spec string `yaml:"spec" json:"spec"`
+	Plan string `yaml:"plan" json:"plan"`
+}
+
+// ValidationConfig controls validation rules.
+type ValidationConfig struct {
+	// Rules maps rule names to a severity: error, warning, or off. Rules
+	// that are not listed report errors.
+	Rules map[string]rules.Severity `yaml:"rules" json:"rules"`
+}
+
+// WorkflowConfig restricts how a spec's status may change between
+// revisions. When Transitions is empty, any change is allowed.
+type WorkflowConfig struct {
+	// Transitions maps a status to the statuses it may move to. A status
+	// that is not a key cannot be left once reached.
+	Transitions map[string][]string `yaml:"transitions" json:"transitions"`
+	// Initial lists the statuses a newly added spec may start in. When
+	// empty, any status is allowed.
+	Initial []string `yaml:"initial" json:"initial"`
+}
+
+// LabelsConfig controls spec labels.
+type LabelsConfig struct {
+	// Allowed lists the labels specs may use. When empty, any label is
+	// allowed.
+	Allowed []string `yaml:"allowed" json:"allowed"`
+}
+
+// StatusWorkflow returns the configured status workflow.
+func (c *Config) StatusWorkflow() workflow.Workflow {
+	return workflow.Workflow{
+		Transitions: c.Workflow.Transitions,
+		Initial:     c.Workflow.Initial,
+	}
+}
+
+// Default returns the configuration used when no .specture.yaml exists.
+func Default() *Config {
+	return &Config{
+		Version:    CurrentVersion,
+		Statuses:   []string{"draft", "approved", "in-progress", "completed", "rejected"},
+		Priorities: []string{"critical", "high", "medium", "low"},
+		List: ListConfig{
+			DefaultStatuses: []string{"draft", "approved", "in-progress"},
+		},
+		Numbering: NumberingConfig{
+			Padding: 3,
+		},
+		Frontmatter: FrontmatterConfig{
+			Required: []string{"status"},
+		},
+		Validation: ValidationConfig{
+			Rules: map[string]rules.Severity{},
+		},
+		Workflow: WorkflowConfig{
+			Transitions: map[string][]string{},
+			Initial:     []string{},
+		},
+		Labels: LabelsConfig{
+			Allowed: []string{},
+		},
+	}
+}
+
+// Load reads .specture.yaml from projectDir and merges it over the defaults.
+// A missing file is not an error; the defaults are returned.
+func Load(projectDir string) (*Config, error) {
+	path := filepath.Join(projectDir, FileName)
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return Default(), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", FileName, err)
+	}
+
+	cfg, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", path, err)
+	}
+	cfg.Path = path
+	return cfg, nil
+}
+
+// Parse decodes configuration file content and merges it over the defaults.
+// Unknown keys are rejected so typos do not silently fall back to defaults.
+func Parse(data []byte) (*Config, error) {
+	var raw struct {
+		Version *int `yaml:"version"`
+	}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse YAML: %w", err)
+	}
+	if raw.Version == nil {
+		return nil, fmt.Errorf("version is required (current version is %d)", CurrentVersion)
+	}
+	if *raw.Version < 1 || *raw.Version > CurrentVersion {
+		return nil, fmt.Errorf("unsupported version %d (this build supports version %d)", *raw.Version, CurrentVersion)
+	}
+
+	cfg := Default()
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to parse YAML: %w", err)
+	}
+	if cfg.Validation.Rules == nil {
+		cfg.Validation.Rules = map[string]rules.Severity{}
+	}
+	if cfg.Workflow.Transitions == nil {
+		cfg.Workflow.Transitions = map[string][]string{}
+	}
+	if cfg.Labels.Allowed == nil {
+		cfg.Labels.Allowed = []string{}
+	}
+
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// validate checks that the merged values are consistent.
+func (c *Config) validate() error {
+	if len(c.Statuses) == 0 {
+		return fmt.Errorf("statuses must list at least one status")
+	}
+	for i, status := range c.Statuses {
+		if status == "" {
+			return fmt.Errorf("statuses must not contain empty values")
+		}
+		if slices.Contains(c.Statuses[:i], status) {
+			return fmt.Errorf("duplicate status %q", status)
+		}
+	}
+	if len(c.Priorities) == 0 {
+		return fmt.Errorf("priorities must list at least one priority")
+	}
+	for i, priority := range c.Priorities {
+		if priority == "" {
+			return fmt.Errorf("priorities must not contain empty values")
+		}
+		if slices.Contains(c.Priorities[:i], priority) {
+			return fmt.Errorf("duplicate priority %q", priority)
+		}
+	}
+	for _, status := range c.List.DefaultStatuses {
+		if !slices.Contains(c.Statuses, status) {
+			return fmt.Errorf("list.default_statuses: unknown status %q", status)
+		}
+	}
+	if c.Numbering.Padding < 1 || c.Numbering.Padding > 9 {
+		return fmt.Errorf("numbering.padding must be between 1 and 9, got %d", c.Numbering.Padding)
+	}
+	for from, targets := range c.Workflow.Transitions {
+		if !slices.Contains(c.Statuses, from) {
+			return fmt.Errorf("workflow.transitions: unknown status %q", from)
+		}
+		for _, to := range targets {
+			if !slices.Contains(c.Statuses, to) {
+				return fmt.Errorf("workflow.transitions.%s: unknown status %q", from, to)
+			}
+		}
+	}
+	for _, status := range c.Workflow.Initial {
+		if !slices.Contains(c.Statuses, status) {
+			return fmt.Errorf("workflow.initial: unknown status %q", status)
+		}
+	}
+	for i, label := range c.Labels.Allowed {
+		switch {
+		case label == "":
+			return fmt.Errorf("labels.allowed must not contain empty values")
+		case strings.ContainsAny(label, ", ") || strings.HasPrefix(label, "!"):
+			return fmt.Errorf("labels.allowed: invalid label %q (labels must not contain spaces or commas or start with !)", label)
+		case slices.Contains(c.Labels.Allowed[:i], label):
+			return fmt.Errorf("labels.allowed: duplicate label %q", label)
+		}
+	}
+	for _, rule := range slices.Sorted(maps.Keys(c.Validation.Rules)) {
+		if err := rules.Check(rule, c.Validation.Rules[rule]); err != nil {
+			return fmt.Errorf("validation.rules: %w", err)
+		}
+	}
+	return nil
+}
+
+// ProjectDir returns the directory holding the configuration file for the
+// given specs directory.
+func ProjectDir(specsDir string) string {
+	return filepath.Dir(specsDir)
+}
+
+// Path returns the absolute path of the custom template for a spec or plan
+// file, or empty when the built-in template should be used.
+func (t TemplatesConfig) Path(projectDir string, plan bool) string {
+	path := t.Spec
+	if plan {
+		path = t.Plan
+	}
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(projectDir, path)
+}
+
+// Marshal encodes the configuration as YAML.
+func (c *Config) Marshal() ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(c); err != nil {
+		return nil, fmt.Errorf("failed to encode config: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, fmt.Errorf("failed to encode config: %w", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,155 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/specture-system/specture/internal/rules"
+)
+
+func TestLoad_MissingFileUsesDefaults(t *testing.T) {
+	cfg, err := Load(t.TempDir())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Path != "" {
+		t.Errorf("expected no config path, got %q", cfg.Path)
+	}
+	if cfg.Numbering.Padding != 3 {
+		t.Errorf("expected default padding 3, got %d", cfg.Numbering.Padding)
+	}
+	if !slices.Equal(cfg.List.DefaultStatuses, []string{"draft", "approved", "in-progress"}) {
+		t.Errorf("unexpected default list statuses: %v", cfg.List.DefaultStatuses)
+	}
+}
+
+func TestLoad_MergesOverDefaults(t *testing.T) {
+	dir := t.TempDir()
+	content := `version: 1
+statuses: [draft, approved, in-progress, completed, rejected, deferred]
+numbering:
+  padding: 4
+validation:
+  rules:
+    numbered-headings: warning
+`
+	if err := os.WriteFile(filepath.Join(dir, FileName), []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := Load(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.Path != filepath.Join(dir, FileName) {
+		t.Errorf("expected config path to be recorded, got %q", cfg.Path)
+	}
+	if !slices.Contains(cfg.Statuses, "deferred") {
+		t.Errorf("expected configured statuses, got %v", cfg.Statuses)
+	}
+	if cfg.Numbering.Padding != 4 {
+		t.Errorf("expected padding 4, got %d", cfg.Numbering.Padding)
+	}
+	if !slices.Equal(cfg.Frontmatter.Required, []string{"status"}) {
+		t.Errorf("expected default required fields to survive, got %v", cfg.Frontmatter.Required)
+	}
+	if cfg.Validation.Rules[rules.NumberedHeadings] != rules.SeverityWarning {
+		t.Errorf("expected rule severity to be loaded, got %v", cfg.Validation.Rules)
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{"missing version", "numbering:\n  padding: 2\n", "version is required"},
+		{"future version", "version: 2\n", "unsupported version 2"},
+		{"unknown key", "version: 1\nstatus: [draft]\n", "field status not found"},
+		{"padding out of range", "version: 1\nnumbering:\n  padding: 0\n", "numbering.padding"},
+		{"unknown default status", "version: 1\nlist:\n  default_statuses: [open]\n", `unknown status "open"`},
+		{"duplicate status", "version: 1\nstatuses: [draft, draft]\n", `duplicate status "draft"`},
+		{"invalid severity", "version: 1\nvalidation:\n  rules:\n    title: fatal\n", `invalid severity "fatal"`},
+		{"unknown rule", "version: 1\nvalidation:\n  rules:\n    numbred-headings: off\n", `unknown validation rule "numbred-headings"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse([]byte(tt.content))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestTemplatesConfig_Path(t *testing.T) {
+	templates := TemplatesConfig{Spec: "templates/spec.md"}
+	if got, want := templates.Path("/project", false), filepath.Join("/project", "templates", "spec.md"); got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+	if got := templates.Path("/project", true); got != "" {
+		t.Errorf("expected built-in plan template, got %s", got)
+	}
+}
+
+func TestParse_Workflow(t *testing.T) {
+	cfg, err := Parse([]byte("version: 1\nworkflow:\n  initial: [draft]\n  transitions:\n    draft: [approved]\n"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	w := cfg.StatusWorkflow()
+	if !w.Allows("draft", "approved") || w.Allows("draft", "completed") || w.AllowsInitial("approved") {
+		t.Errorf("unexpected workflow: %+v", w)
+	}
+
+	if _, err := Parse([]byte("version: 1\nworkflow:\n  transitions:\n    draft: [shipped]\n")); err == nil || !strings.Contains(err.Error(), `unknown status "shipped"`) {
+		t.Errorf("expected unknown status error, got %v", err)
+	}
+}
+
+func TestParse_Labels(t *testing.T) {
+	cfg, err := Parse([]byte("version: 1\nlabels:\n  allowed: [cli, docs]\n"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Join(cfg.Labels.Allowed, ",") != "cli,docs" {
+		t.Errorf("unexpected allowed labels: %v", cfg.Labels.Allowed)
+	}
+
+	for _, tt := range []struct{ config, want string }{
+		{"labels:\n  allowed: [cli, cli]", `duplicate label "cli"`},
+		{"labels:\n  allowed: [\"!docs\"]", `invalid label "!docs"`},
+		{"labels:\n  allowed: [\"a,b\"]", `invalid label "a,b"`},
+	} {
+		if _, err := Parse([]byte("version: 1\n" + tt.config + "\n")); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("expected error %q for %q, got %v", tt.want, tt.config, err)
+		}
+	}
+}
+
+func TestParse_Priorities(t *testing.T) {
+	if got := Default().Priorities; strings.Join(got, ",") != "critical,high,medium,low" {
+		t.Errorf("unexpected default priorities: %v", got)
+	}
+
+	cfg, err := Parse([]byte("version: 1\npriorities: [p0, p1, p2]\n"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Join(cfg.Priorities, ",") != "p0,p1,p2" {
+		t.Errorf("unexpected priorities: %v", cfg.Priorities)
+	}
+
+	for _, tt := range []struct{ config, want string }{
+		{"priorities: []", "priorities must list at least one priority"},
+		{"priorities: [p0, p0]", `duplicate priority "p0"`},
+	} {
+		if _, err := Parse([]byte("version: 1\n" + tt.config + "\n")); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("expected error %q for %q, got %v", tt.want, tt.config, err)
+		}
+	}
+}
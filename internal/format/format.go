@@ -0,0 +1,295 @@
+// Package format writes lists of records in the output formats shared by the
+// CLI commands: aligned text, CSV, Markdown tables, JSON, NDJSON, and YAML.
+package format
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Column describes one field of the records in a table.
+type Column struct {
+	// Name is the field's key in structured formats and in CSV headers.
+	Name string
+	// Header is the column heading in text and Markdown output.
+	Header string
+	// Optional columns are left out of text and Markdown output when no
+	// record has a value for them.
+	Optional bool
+}
+
+// Cell is one value of a record. Structured formats encode Data; tabular
+// formats print Text.
+type Cell struct {
+	Data any
+	Text string
+}
+
+// Value returns a cell whose text is derived from data: strings as-is, string
+// lists joined with ", ", numbers and booleans in decimal form, Stringers via
+// String, and anything else as compact JSON.
+func Value(data any) Cell {
+	return Cell{Data: data, Text: text(data)}
+}
+
+// Table is a list of records sharing the same columns. Each row holds one
+// cell per column.
+type Table struct {
+	Columns []Column
+	Rows    [][]Cell
+}
+
+// Formatter writes a table in one output format.
+type Formatter struct {
+	Name string
+	// Structured formats encode cell data and suit machine consumers;
+	// the others print cell text for people and spreadsheets.
+	Structured bool
+	Write      func(w io.Writer, table Table) error
+}
+
+var formatters = map[string]Formatter{}
+
+func init() {
+	Register(Formatter{Name: "text", Write: writeText})
+	Register(Formatter{Name: "csv", Write: writeCSV})
+	Register(Formatter{Name: "markdown", Write: writeMarkdown})
+	Register(Formatter{Name: "json", Structured: true, Write: writeJSON})
+	Register(Formatter{Name: "ndjson", Structured: true, Write: writeNDJSON})
+	Register(Formatter{Name: "yaml", Structured: true, Write: writeYAML})
+}
+
+// Register adds f to the registry, replacing any formatter with the same
+// name.
+func Register(f Formatter) {
+	formatters[f.Name] = f
+}
+
+// Names returns the registered format names in alphabetical order.
+func Names() []string {
+	names := make([]string, 0, len(formatters))
+	for name := range formatters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Get returns the formatter registered under name.
+func Get(name string) (Formatter, error) {
+	f, ok := formatters[name]
+	if !ok {
+		return Formatter{}, fmt.Errorf("invalid format: %s (must be one of: %s)", name, strings.Join(Names(), ", "))
+	}
+	return f, nil
+}
+
+// visibleColumns returns the indexes of the columns to print in text and
+// Markdown output, dropping optional columns without any value.
+func visibleColumns(table Table) []int {
+	var visible []int
+	for i, column := range table.Columns {
+		if column.Optional && !slices.ContainsFunc(table.Rows, func(row []Cell) bool { return row[i].Text != "" }) {
+			continue
+		}
+		visible = append(visible, i)
+	}
+	return visible
+}
+
+// writeText prints the table with a header row and columns padded to their
+// widest value, separated by two spaces.
+func writeText(w io.Writer, table Table) error {
+	visible := visibleColumns(table)
+	widths := make([]int, len(visible))
+	for i, c := range visible {
+		widths[i] = utf8.RuneCountInString(table.Columns[c].Header)
+		for _, row := range table.Rows {
+			widths[i] = max(widths[i], utf8.RuneCountInString(row[c].Text))
+		}
+	}
+
+	var buf bytes.Buffer
+	line := func(values func(c int) string) {
+		for i, c := range visible {
+			if i > 0 {
+				buf.WriteString("  ")
+			}
+			fmt.Fprintf(&buf, "%-*s", widths[i], values(c))
+		}
+		buf.WriteByte('\n')
+	}
+	line(func(c int) string { return table.Columns[c].Header })
+	for _, row := range table.Rows {
+		line(func(c int) string { return row[c].Text })
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// writeCSV prints the table as CSV with the column names as the header row.
+// Every column is included so the layout does not depend on the data.
+func writeCSV(w io.Writer, table Table) error {
+	out := csv.NewWriter(w)
+	header := make([]string, len(table.Columns))
+	for i, column := range table.Columns {
+		header[i] = column.Name
+	}
+	if err := out.Write(header); err != nil {
+		return err
+	}
+	for _, row := range table.Rows {
+		record := make([]string, len(row))
+		for i, cell := range row {
+			record[i] = cell.Text
+		}
+		if err := out.Write(record); err != nil {
+			return err
+		}
+	}
+	out.Flush()
+	return out.Error()
+}
+
+// markdownEscaper keeps cell text from breaking the table layout.
+var markdownEscaper = strings.NewReplacer("|", `\|`, "\r\n", " ", "\n", " ")
+
+// writeMarkdown prints the table as a GitHub-flavored Markdown table.
+func writeMarkdown(w io.Writer, table Table) error {
+	visible := visibleColumns(table)
+	var buf bytes.Buffer
+	line := func(values func(c int) string) {
+		buf.WriteByte('|')
+		for _, c := range visible {
+			buf.WriteString(" " + markdownEscaper.Replace(values(c)) + " |")
+		}
+		buf.WriteByte('\n')
+	}
+	line(func(c int) string { return table.Columns[c].Header })
+	line(func(int) string { return "---" })
+	for _, row := range table.Rows {
+		line(func(c int) string { return row[c].Text })
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// writeJSON prints the table as an indented JSON array of objects whose keys
+// follow the column order.
+func writeJSON(w io.Writer, table Table) error {
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	for i, row := range table.Rows {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if err := encodeObject(&buf, table.Columns, row); err != nil {
+			return err
+		}
+	}
+	buf.WriteByte(']')
+
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, buf.Bytes(), "", "  "); err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	indented.WriteByte('\n')
+	_, err := w.Write(indented.Bytes())
+	return err
+}
+
+// writeNDJSON prints one compact JSON object per line, so output can be
+// streamed and processed record by record.
+func writeNDJSON(w io.Writer, table Table) error {
+	for _, row := range table.Rows {
+		var buf bytes.Buffer
+		if err := encodeObject(&buf, table.Columns, row); err != nil {
+			return err
+		}
+		buf.WriteByte('\n')
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// encodeObject writes row as a compact JSON object keyed by column name.
+func encodeObject(buf *bytes.Buffer, columns []Column, row []Cell) error {
+	buf.WriteByte('{')
+	for i, column := range columns {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(column.Name)
+		value, err := json.Marshal(row[i].Data)
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return nil
+}
+
+// writeYAML prints the table as a YAML sequence of mappings whose keys follow
+// the column order.
+func writeYAML(w io.Writer, table Table) error {
+	doc := &yaml.Node{Kind: yaml.SequenceNode}
+	for _, row := range table.Rows {
+		record := &yaml.Node{Kind: yaml.MappingNode}
+		for i, column := range table.Columns {
+			value := &yaml.Node{}
+			if err := value.Encode(row[i].Data); err != nil {
+				return fmt.Errorf("failed to marshal YAML: %w", err)
+			}
+			record.Content = append(record.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: column.Name}, value)
+		}
+		doc.Content = append(doc.Content, record)
+	}
+	if len(doc.Content) == 0 {
+		doc.Style = yaml.FlowStyle
+	}
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(doc); err != nil {
+		return fmt.Errorf("failed to marshal YAML: %w", err)
+	}
+	return encoder.Close()
+}
+
+// text renders data for tabular output.
+func text(data any) string {
+	switch v := data.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []string:
+		return strings.Join(v, ", ")
+	case bool:
+		return strconv.FormatBool(v)
+	case int:
+		return strconv.Itoa(v)
+	case fmt.Stringer:
+		return v.String()
+	}
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Sprint(data)
+	}
+	return string(encoded)
+}
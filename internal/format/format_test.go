@@ -0,0 +1,118 @@
+package format
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func testTable() Table {
+	return Table{
+		Columns: []Column{
+			{Name: "ref", Header: "REF"},
+			{Name: "name", Header: "NAME"},
+			{Name: "labels", Header: "LABELS", Optional: true},
+			{Name: "assignee", Header: "ASSIGNEE", Optional: true},
+		},
+		Rows: [][]Cell{
+			{Value("1"), Value("First | pipe"), Value([]string{"cli", "docs"}), Value("")},
+			{Value("1.2"), Value(`Quoted, "name"`), Value([]string{}), Value("")},
+		},
+	}
+}
+
+func write(t *testing.T, name string, table Table) string {
+	t.Helper()
+	f, err := Get(name)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := f.Write(&buf, table); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return buf.String()
+}
+
+func TestFormatters(t *testing.T) {
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{"text", "REF  NAME            LABELS   \n" +
+			"1    First | pipe    cli, docs\n" +
+			"1.2  Quoted, \"name\"           \n"},
+		{"csv", "ref,name,labels,assignee\n" +
+			"1,First | pipe,\"cli, docs\",\n" +
+			"1.2,\"Quoted, \"\"name\"\"\",,\n"},
+		{"markdown", "| REF | NAME | LABELS |\n" +
+			"| --- | --- | --- |\n" +
+			"| 1 | First \\| pipe | cli, docs |\n" +
+			"| 1.2 | Quoted, \"name\" |  |\n"},
+		{"json", "[\n  {\n    \"ref\": \"1\",\n    \"name\": \"First | pipe\",\n    \"labels\": [\n      \"cli\",\n      \"docs\"\n    ],\n    \"assignee\": \"\"\n  },\n" +
+			"  {\n    \"ref\": \"1.2\",\n    \"name\": \"Quoted, \\\"name\\\"\",\n    \"labels\": [],\n    \"assignee\": \"\"\n  }\n]\n"},
+		{"ndjson", "{\"ref\":\"1\",\"name\":\"First | pipe\",\"labels\":[\"cli\",\"docs\"],\"assignee\":\"\"}\n" +
+			"{\"ref\":\"1.2\",\"name\":\"Quoted, \\\"name\\\"\",\"labels\":[],\"assignee\":\"\"}\n"},
+		{"yaml", "- ref: \"1\"\n  name: First | pipe\n  labels:\n    - cli\n    - docs\n  assignee: \"\"\n" +
+			"- ref: \"1.2\"\n  name: Quoted, \"name\"\n  labels: []\n  assignee: \"\"\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			if got := write(t, tt.format, testTable()); got != tt.want {
+				t.Errorf("unexpected output:\n%s\nwant:\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatters_Empty(t *testing.T) {
+	table := Table{Columns: []Column{{Name: "ref", Header: "REF"}}}
+	for format, want := range map[string]string{
+		"json":   "[]\n",
+		"ndjson": "",
+		"yaml":   "[]\n",
+		"csv":    "ref\n",
+	} {
+		if got := write(t, format, table); got != want {
+			t.Errorf("%s: expected %q, got %q", format, want, got)
+		}
+	}
+}
+
+func TestGet_UnknownFormat(t *testing.T) {
+	_, err := Get("xml")
+	if err == nil || !strings.Contains(err.Error(), "invalid format: xml (must be one of: csv, json, markdown, ndjson, text, yaml)") {
+		t.Errorf("expected invalid format error, got %v", err)
+	}
+}
+
+func TestWriteTree(t *testing.T) {
+	roots := []*TreeNode{
+		{Text: "1", Children: []*TreeNode{
+			{Text: "1.1", Children: []*TreeNode{{Text: "1.1.1"}}},
+			{Text: "1.2", Children: []*TreeNode{{Text: "1.2.1"}}},
+		}},
+		{Text: "2"},
+	}
+	var buf bytes.Buffer
+	if err := WriteTree(&buf, roots); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "1\n├── 1.1\n│   └── 1.1.1\n└── 1.2\n    └── 1.2.1\n2\n"
+	if buf.String() != want {
+		t.Errorf("unexpected tree:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
+
+func TestText_AlignsByCharacters(t *testing.T) {
+	table := Table{
+		Columns: []Column{{Name: "status", Header: "STATUS"}, {Name: "ref", Header: "REF"}},
+		Rows:    [][]Cell{{Value("draft → approved"), Value("1")}, {Value("draft"), Value("2")}},
+	}
+	want := "STATUS            REF\n" +
+		"draft → approved  1  \n" +
+		"draft             2  \n"
+	if got := write(t, "text", table); got != want {
+		t.Errorf("unexpected output:\n%s\nwant:\n%s", got, want)
+	}
+}
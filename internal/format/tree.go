@@ -0,0 +1,36 @@
+package format
+
+import (
+	"bytes"
+	"io"
+)
+
+// TreeNode is one line of a tree and the lines nested under it.
+type TreeNode struct {
+	Text     string
+	Children []*TreeNode
+}
+
+// WriteTree prints roots and their descendants, one node per line, joining
+// children to their parent with box-drawing connectors. Roots are printed
+// without a connector.
+func WriteTree(w io.Writer, roots []*TreeNode) error {
+	var buf bytes.Buffer
+	var visit func(node *TreeNode, prefix string)
+	visit = func(node *TreeNode, prefix string) {
+		for i, child := range node.Children {
+			connector, indent := "├── ", "│   "
+			if i == len(node.Children)-1 {
+				connector, indent = "└── ", "    "
+			}
+			buf.WriteString(prefix + connector + child.Text + "\n")
+			visit(child, prefix+indent)
+		}
+	}
+	for _, root := range roots {
+		buf.WriteString(root.Text + "\n")
+		visit(root, "")
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
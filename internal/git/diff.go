@@ -0,0 +1,76 @@
+package git
+
+import (
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// FileChange is a file that differs between two revisions.
+type FileChange struct {
+	// Status is A (added), D (deleted), M (modified), or R (renamed). Copies
+	// are reported as additions and type changes as modifications.
+	Status string
+	// OldPath is the path at the first revision. It equals Path except for
+	// renames.
+	OldPath string
+	Path    string
+}
+
+// MergeBase returns the commit hash of the best common ancestor of the
+// revisions a and b.
+func MergeBase(dir, a, b string) (string, error) {
+	var stderr bytes.Buffer
+	cmd := exec.Command("git", "merge-base", a, b)
+	cmd.Dir = dir
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("failed to find merge base of %s and %s: %s", a, b, msg)
+		}
+		return "", fmt.Errorf("%s and %s have no common history", a, b)
+	}
+	return strings.TrimSpace(string(output)), nil
+}
+
+// DiffFiles lists the files under dir that differ between the revisions from
+// and to, with paths relative to dir using forward slashes. Only nearly
+// identical files (90% similar) are paired as renames, so a deleted file and
+// an unrelated new one that share boilerplate are not mistaken for a move.
+func DiffFiles(dir, from, to string) ([]FileChange, error) {
+	var stderr bytes.Buffer
+	cmd := exec.Command("git", "diff-tree", "-r", "-M90%", "-z", "--name-status", "--relative", from, to, "--", ".")
+	cmd.Dir = dir
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff %s and %s: %s", from, to, strings.TrimSpace(stderr.String()))
+	}
+
+	// With -z every status and path is NUL-terminated; renames and copies
+	// carry a score and two paths.
+	fields := strings.Split(strings.TrimSuffix(string(output), "\x00"), "\x00")
+	var changes []FileChange
+	for i := 0; i < len(fields) && fields[i] != ""; {
+		status := fields[i][:1]
+		paths := 1
+		if status == "R" || status == "C" {
+			paths = 2
+		}
+		if i+paths >= len(fields) {
+			return nil, fmt.Errorf("failed to parse diff of %s and %s", from, to)
+		}
+		change := FileChange{Status: status, OldPath: fields[i+1], Path: fields[i+paths]}
+		switch status {
+		case "C":
+			change.Status, change.OldPath = "A", change.Path
+		case "T":
+			change.Status = "M"
+		}
+		changes = append(changes, change)
+		i += paths + 1
+	}
+	return changes, nil
+}
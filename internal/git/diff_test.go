@@ -0,0 +1,61 @@
+package git
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/specture-system/specture/internal/testhelpers"
+)
+
+func TestDiffFiles(t *testing.T) {
+	dir := t.TempDir()
+	testhelpers.InitGitRepo(t, dir)
+	commit := func(message string) {
+		t.Helper()
+		for _, args := range [][]string{{"add", "-A"}, {"commit", "-m", message}} {
+			if err := testhelpers.RunGitCommand(dir, args); err != nil {
+				t.Fatalf("git %v failed: %v", args, err)
+			}
+		}
+	}
+
+	body := strings.Repeat("A line that keeps the file recognizable.\n", 5)
+	testhelpers.WriteFile(t, dir, "specs/001-old/SPEC.md", body)
+	testhelpers.WriteFile(t, dir, "specs/002-kept/SPEC.md", "kept\n")
+	testhelpers.WriteFile(t, dir, "specs/003-gone/SPEC.md", "gone\n")
+	testhelpers.WriteFile(t, dir, "README.md", "outside\n")
+	commit("base")
+
+	if err := os.Rename(filepath.Join(dir, "specs/001-old"), filepath.Join(dir, "specs/001-new")); err != nil {
+		t.Fatal(err)
+	}
+	testhelpers.WriteFile(t, dir, "specs/002-kept/SPEC.md", "kept, edited\n")
+	if err := os.RemoveAll(filepath.Join(dir, "specs/003-gone")); err != nil {
+		t.Fatal(err)
+	}
+	testhelpers.WriteFile(t, dir, "specs/004-added/SPEC.md", "added\n")
+	testhelpers.WriteFile(t, dir, "README.md", "outside, edited\n")
+	commit("change")
+
+	specsDir := filepath.Join(dir, "specs")
+	mergeBase, err := MergeBase(specsDir, "HEAD~1", "HEAD")
+	if err != nil {
+		t.Fatalf("MergeBase() error = %v", err)
+	}
+	changes, err := DiffFiles(specsDir, mergeBase, "HEAD")
+	if err != nil {
+		t.Fatalf("DiffFiles() error = %v", err)
+	}
+	want := []FileChange{
+		{Status: "R", OldPath: "001-old/SPEC.md", Path: "001-new/SPEC.md"},
+		{Status: "M", OldPath: "002-kept/SPEC.md", Path: "002-kept/SPEC.md"},
+		{Status: "D", OldPath: "003-gone/SPEC.md", Path: "003-gone/SPEC.md"},
+		{Status: "A", OldPath: "004-added/SPEC.md", Path: "004-added/SPEC.md"},
+	}
+	if !reflect.DeepEqual(changes, want) {
+		t.Errorf("DiffFiles() = %+v, want %+v", changes, want)
+	}
+}
@@ -0,0 +1,126 @@
+package git
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// Commit is the author and author date of a commit.
+type Commit struct {
+	Author string
+	Date   time.Time
+}
+
+// FileHistory is the earliest and latest commit that touched a file.
+type FileHistory struct {
+	First Commit
+	Last  Commit
+}
+
+// IsRepository reports whether dir is inside a git work tree.
+func IsRepository(dir string) bool {
+	cmd := exec.Command("git", "rev-parse", "--is-inside-work-tree")
+	cmd.Dir = dir
+	output, err := cmd.Output()
+	return err == nil && strings.TrimSpace(string(output)) == "true"
+}
+
+// History returns the commit history of the files under dir, or only of
+// paths when given, keyed by their path relative to dir with forward
+// slashes. Renames are followed, so a file's first commit may have been made
+// under an earlier name. Files that were never committed have no entry, and
+// the map is empty when dir is not in a git repository or the repository has
+// no commits.
+func History(dir string, paths ...string) (map[string]FileHistory, error) {
+	history := make(map[string]FileHistory)
+	if !IsRepository(dir) || VerifyRevision(dir, "HEAD") != nil {
+		return history, nil
+	}
+
+	logs := [][]string{{"--", "."}}
+	if len(paths) > 0 {
+		// --follow tracks renames of a single file, so each path needs a log
+		// of its own.
+		logs = nil
+		for _, path := range paths {
+			logs = append(logs, []string{"--follow", "--", path})
+		}
+	}
+	for _, args := range logs {
+		if err := readHistory(dir, history, args...); err != nil {
+			return nil, err
+		}
+	}
+	return history, nil
+}
+
+// readHistory adds the commits of git log with args to history.
+func readHistory(dir string, history map[string]FileHistory, args ...string) error {
+	var stderr bytes.Buffer
+	cmd := exec.Command("git", append([]string{"-c", "core.quotePath=false", "log", "-M", "--name-status", "--relative", "--format=%x00%aI%x00%an"}, args...)...)
+	cmd.Dir = dir
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return fmt.Errorf("failed to read git history: %s", strings.TrimSpace(stderr.String()))
+	}
+
+	// The log runs newest first. renamed maps each earlier name of a file to
+	// its current path, and ended marks paths whose older commits belong to
+	// a file that was deleted before the current one was added.
+	renamed := make(map[string]string)
+	ended := make(map[string]bool)
+	var commit Commit
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if header, ok := strings.CutPrefix(line, "\x00"); ok {
+			date, author, _ := strings.Cut(header, "\x00")
+			parsed, err := time.Parse(time.RFC3339, date)
+			if err != nil {
+				return fmt.Errorf("failed to parse commit date %q: %w", date, err)
+			}
+			commit = Commit{Author: author, Date: parsed}
+			continue
+		}
+
+		fields := strings.Split(line, "\t")
+		if len(fields) < 2 {
+			continue
+		}
+		status, paths := fields[0], fields[1:]
+		path := paths[len(paths)-1]
+		if current, ok := renamed[path]; ok {
+			path = current
+		}
+		if ended[path] {
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(status, "D"):
+			ended[path] = true
+			continue
+		case strings.HasPrefix(status, "R") && len(paths) == 2:
+			renamed[paths[0]] = path
+		}
+
+		entry, seen := history[path]
+		if !seen || commit.Date.After(entry.Last.Date) {
+			entry.Last = commit
+		}
+		if !seen || !commit.Date.After(entry.First.Date) {
+			entry.First = commit
+		}
+		history[path] = entry
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read git history: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package git
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/specture-system/specture/internal/testhelpers"
+)
+
+func TestHistory(t *testing.T) {
+	dir := t.TempDir()
+	testhelpers.InitGitRepo(t, dir)
+
+	commit := func(date, author string) {
+		t.Helper()
+		for _, args := range [][]string{
+			{"add", "-A"},
+			{"commit", "-m", "change", "--date", date, "--author", author + " <" + author + "@example.com>"},
+		} {
+			if err := testhelpers.RunGitCommand(dir, args); err != nil {
+				t.Fatalf("git %v failed: %v", args, err)
+			}
+		}
+	}
+
+	body := "---\nstatus: draft\n---\n\n# Feature\n\nA spec long enough for git to detect renames.\n"
+	testhelpers.WriteFile(t, dir, "specs/001-first/SPEC.md", body)
+	testhelpers.WriteFile(t, dir, "specs/002-second/SPEC.md", body+"Second.\n")
+	testhelpers.WriteFile(t, dir, "specs/003-reused/SPEC.md", body+"Original.\n")
+	testhelpers.WriteFile(t, dir, "README.md", "outside specs\n")
+	commit("2026-01-01T10:00:00Z", "Alice")
+
+	if err := os.Rename(filepath.Join(dir, "specs/001-first"), filepath.Join(dir, "specs/001-renamed")); err != nil {
+		t.Fatal(err)
+	}
+	commit("2026-02-01T10:00:00Z", "Bob")
+
+	testhelpers.WriteFile(t, dir, "specs/002-second/SPEC.md", body+"Second, edited.\n")
+	if err := os.RemoveAll(filepath.Join(dir, "specs/003-reused")); err != nil {
+		t.Fatal(err)
+	}
+	commit("2026-03-01T10:00:00Z", "Carol")
+
+	testhelpers.WriteFile(t, dir, "specs/003-reused/SPEC.md", "# Unrelated\n")
+	commit("2026-04-01T10:00:00Z", "Dave")
+	testhelpers.WriteFile(t, dir, "specs/004-uncommitted/SPEC.md", body)
+
+	history, err := History(filepath.Join(dir, "specs"))
+	if err != nil {
+		t.Fatalf("History() error = %v", err)
+	}
+
+	tests := []struct {
+		path        string
+		first, last string
+		firstAuthor string
+		lastAuthor  string
+	}{
+		{"001-renamed/SPEC.md", "2026-01-01", "2026-02-01", "Alice", "Bob"},
+		{"002-second/SPEC.md", "2026-01-01", "2026-03-01", "Alice", "Carol"},
+		{"003-reused/SPEC.md", "2026-04-01", "2026-04-01", "Dave", "Dave"},
+	}
+	for _, tt := range tests {
+		entry, ok := history[tt.path]
+		if !ok {
+			t.Errorf("no history for %s", tt.path)
+			continue
+		}
+		if got := entry.First.Date.Format("2006-01-02"); got != tt.first || entry.First.Author != tt.firstAuthor {
+			t.Errorf("%s: first commit = %s by %s, want %s by %s", tt.path, got, entry.First.Author, tt.first, tt.firstAuthor)
+		}
+		if got := entry.Last.Date.Format("2006-01-02"); got != tt.last || entry.Last.Author != tt.lastAuthor {
+			t.Errorf("%s: last commit = %s by %s, want %s by %s", tt.path, got, entry.Last.Author, tt.last, tt.lastAuthor)
+		}
+	}
+	for _, path := range []string{"004-uncommitted/SPEC.md", "../README.md", "README.md"} {
+		if _, ok := history[path]; ok {
+			t.Errorf("unexpected history for %s", path)
+		}
+	}
+
+	// Named paths are read one by one, following renames.
+	scoped, err := History(filepath.Join(dir, "specs"), "001-renamed/SPEC.md", "003-reused/SPEC.md", "004-uncommitted/SPEC.md")
+	if err != nil {
+		t.Fatalf("History() with paths error = %v", err)
+	}
+	if len(scoped) != 2 || scoped["001-renamed/SPEC.md"] != history["001-renamed/SPEC.md"] || scoped["003-reused/SPEC.md"] != history["003-reused/SPEC.md"] {
+		t.Errorf("History() with paths = %+v, want the entries of the full history", scoped)
+	}
+
+	empty, err := History(t.TempDir())
+	if err != nil || len(empty) != 0 {
+		t.Errorf("History() outside a repository = %v, %v; want empty", empty, err)
+	}
+}
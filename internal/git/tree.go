@@ -0,0 +1,88 @@
+package git
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// VerifyRevision checks that rev names a commit in the repository containing
+// dir.
+func VerifyRevision(dir, rev string) error {
+	cmd := exec.Command("git", "rev-parse", "--verify", "--quiet", rev+"^{commit}")
+	cmd.Dir = dir
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("unknown git revision: %s", rev)
+	}
+	return nil
+}
+
+// ReadFiles returns the content of the files under dir at revision rev whose
+// paths, relative to dir with forward slashes, satisfy keep. The tree is
+// listed and read with two git commands however many files match, instead
+// of one git show per file.
+func ReadFiles(dir, rev string, keep func(path string) bool) (map[string][]byte, error) {
+	var stderr bytes.Buffer
+	cmd := exec.Command("git", "ls-tree", "-r", "-z", rev, "--", ".")
+	cmd.Dir = dir
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list files at %s: %s", rev, strings.TrimSpace(stderr.String()))
+	}
+
+	// Each entry is "<mode> <type> <object>\t<path>", NUL-terminated, with
+	// the path relative to dir.
+	var paths, objects []string
+	for _, entry := range strings.Split(strings.TrimSuffix(string(output), "\x00"), "\x00") {
+		meta, path, ok := strings.Cut(entry, "\t")
+		fields := strings.Fields(meta)
+		if !ok || len(fields) != 3 || fields[1] != "blob" || !keep(path) {
+			continue
+		}
+		paths = append(paths, path)
+		objects = append(objects, fields[2])
+	}
+
+	files := make(map[string][]byte, len(paths))
+	if len(paths) == 0 {
+		return files, nil
+	}
+
+	stderr.Reset()
+	cmd = exec.Command("git", "cat-file", "--batch")
+	cmd.Dir = dir
+	cmd.Stdin = strings.NewReader(strings.Join(objects, "\n") + "\n")
+	cmd.Stderr = &stderr
+	output, err = cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read files at %s: %s", rev, strings.TrimSpace(stderr.String()))
+	}
+
+	// Each object is "<object> <type> <size>\n<content>\n", in input order.
+	reader := bufio.NewReader(bytes.NewReader(output))
+	for _, path := range paths {
+		header, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, fmt.Errorf("failed to read files at %s: %w", rev, err)
+		}
+		fields := strings.Fields(header)
+		if len(fields) != 3 {
+			return nil, fmt.Errorf("failed to read %s at %s: %s", path, rev, strings.TrimSpace(header))
+		}
+		size, err := strconv.Atoi(fields[2])
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s at %s: %w", path, rev, err)
+		}
+		content := make([]byte, size+1)
+		if _, err := io.ReadFull(reader, content); err != nil {
+			return nil, fmt.Errorf("failed to read %s at %s: %w", path, rev, err)
+		}
+		files[path] = content[:size]
+	}
+	return files, nil
+}
@@ -0,0 +1,57 @@
+package git
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/specture-system/specture/internal/testhelpers"
+)
+
+func TestReadFiles(t *testing.T) {
+	dir := t.TempDir()
+	testhelpers.InitGitRepo(t, dir)
+
+	testhelpers.WriteFile(t, dir, "specs/001-first/SPEC.md", "first\n")
+	testhelpers.WriteFile(t, dir, "specs/001-first/002-child/PLAN.md", "")
+	testhelpers.WriteFile(t, dir, "specs/003 spaced/SPEC.md", "spaced\n")
+	testhelpers.WriteFile(t, dir, "specs/notes.txt", "skipped\n")
+	testhelpers.WriteFile(t, dir, "README.md", "outside\n")
+	for _, args := range [][]string{{"add", "."}, {"commit", "-m", "initial"}} {
+		if err := testhelpers.RunGitCommand(dir, args); err != nil {
+			t.Fatalf("git %v failed: %v", args, err)
+		}
+	}
+	testhelpers.WriteFile(t, dir, "specs/001-first/SPEC.md", "changed\n")
+
+	if err := VerifyRevision(dir, "HEAD"); err != nil {
+		t.Fatalf("VerifyRevision(HEAD) error = %v", err)
+	}
+	if err := VerifyRevision(dir, "no-such-branch"); err == nil {
+		t.Error("expected error for unknown revision")
+	}
+
+	files, err := ReadFiles(filepath.Join(dir, "specs"), "HEAD", func(path string) bool {
+		return strings.HasSuffix(path, ".md")
+	})
+	if err != nil {
+		t.Fatalf("ReadFiles() error = %v", err)
+	}
+	want := map[string]string{
+		"001-first/SPEC.md":           "first\n",
+		"001-first/002-child/PLAN.md": "",
+		"003 spaced/SPEC.md":          "spaced\n",
+	}
+	if len(files) != len(want) {
+		t.Errorf("ReadFiles() returned %d files, want %d: %v", len(files), len(want), files)
+	}
+	for path, content := range want {
+		if got, ok := files[path]; !ok || string(got) != content {
+			t.Errorf("ReadFiles()[%q] = %q, %v; want %q", path, got, ok, content)
+		}
+	}
+
+	if _, err := ReadFiles(dir, "no-such-revision", func(string) bool { return true }); err == nil {
+		t.Error("expected error for unknown revision")
+	}
+}
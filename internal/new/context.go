@@ -19,12 +19,24 @@ const (
 
 // Options holds user choices for new file creation.
 type Options struct {
+	// SpecsDir is the specs directory to create files in. When empty, the
+	// specs directory inside workDir is used.
+	SpecsDir  string
 	Title     string
 	ParentRef string
 	SpecRef   string
 	Plan      bool
+	// Padding is the zero-padded width of the number in new directory names.
+	// When zero, DefaultPadding is used.
+	Padding int
+	// Template is a custom template for the new file, rendered with SpecData.
+	// When empty, the built-in spec or plan template is used.
+	Template string
 }
 
+// DefaultPadding is the number width used when Options.Padding is unset.
+const DefaultPadding = 3
+
 // NewCommandContext holds information needed to create a new spec or plan file.
 type NewCommandContext struct {
 	WorkDir      string
@@ -39,6 +51,7 @@ type NewCommandContext struct {
 	FileName     string
 	RelativePath string
 	FilePath     string
+	Template     string
 }
 
 // NewContext creates a new NewCommandContext for spec or plan creation.
@@ -51,7 +64,10 @@ func NewContext(workDir string, opts Options) (*NewCommandContext, error) {
 		return nil, fmt.Errorf("--spec cannot be combined with --parent")
 	}
 
-	specsDir := filepath.Join(workDir, "specs")
+	specsDir := opts.SpecsDir
+	if specsDir == "" {
+		specsDir = filepath.Join(workDir, "specs")
+	}
 	fileName := specFileName
 	kind := "spec"
 	if opts.Plan {
@@ -72,7 +88,11 @@ func NewContext(workDir string, opts Options) (*NewCommandContext, error) {
 		return nil, err
 	}
 
-	dirName := fmt.Sprintf("%03d-%s", number, slug)
+	padding := opts.Padding
+	if padding <= 0 {
+		padding = DefaultPadding
+	}
+	dirName := fmt.Sprintf("%0*d-%s", padding, number, slug)
 	baseDir := specsDir
 	if parentPath != "" {
 		baseDir = filepath.Dir(parentPath)
@@ -108,12 +128,19 @@ func NewContext(workDir string, opts Options) (*NewCommandContext, error) {
 		FileName:     fileName,
 		RelativePath: relativePath,
 		FilePath:     filePath,
+		Template:     opts.Template,
 	}, nil
 }
 
 // CreateFile creates the target SPEC.md or PLAN.md file.
 func (c *NewCommandContext) CreateFile() error {
-	content, err := RenderFile(c.Title, c.Author, c.FileName)
+	var content string
+	var err error
+	if c.Template != "" {
+		content, err = RenderCustom(c.Template, c.Title, c.Author)
+	} else {
+		content, err = RenderFile(c.Title, c.Author, c.FileName)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to render %s: %w", c.FileName, err)
 	}
@@ -3,6 +3,7 @@ package new
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -181,3 +182,31 @@ func writeFile(t *testing.T, path, content string) {
 		t.Fatalf("failed to write %s: %v", path, err)
 	}
 }
+
+func TestNewContext_PaddingAndTemplate(t *testing.T) {
+	workDir := t.TempDir()
+
+	ctx, err := NewContext(workDir, Options{
+		Title:    "Padded Spec",
+		Padding:  5,
+		Template: "---\nstatus: draft\nauthor: {{.Author}}\n---\n\n# {{.Title}}\n\nCustom body.\n",
+	})
+	if err != nil {
+		t.Fatalf("NewContext() error = %v", err)
+	}
+	wantPath := filepath.Join(workDir, "specs", "00000-padded-spec", "SPEC.md")
+	if ctx.FilePath != wantPath {
+		t.Fatalf("FilePath = %q, want %q", ctx.FilePath, wantPath)
+	}
+
+	if err := ctx.CreateFile(); err != nil {
+		t.Fatalf("CreateFile() error = %v", err)
+	}
+	content, err := os.ReadFile(ctx.FilePath)
+	if err != nil {
+		t.Fatalf("failed to read created file: %v", err)
+	}
+	if !strings.Contains(string(content), "# Padded Spec\n\nCustom body.") {
+		t.Errorf("expected custom template to be rendered, got:\n%s", content)
+	}
+}
@@ -50,15 +50,19 @@ func FindNextSpecNumber(specsDir, parentPath string) (int, error) {
 		return 0, nil
 	}
 
-	specs, err := specpkg.FindSpecsInScope(specsDir, parentPath)
+	tree, err := specpkg.BuildTree(specsDir)
+	if err != nil {
+		return 0, err
+	}
+	siblings, err := tree.Scope(parentPath)
 	if err != nil {
 		return 0, err
 	}
 
 	maxNumber := -1
-	for _, info := range specs {
-		if info.Number > maxNumber {
-			maxNumber = info.Number
+	for _, node := range siblings {
+		if node.Spec.Number > maxNumber {
+			maxNumber = node.Spec.Number
 		}
 	}
 
@@ -148,6 +152,18 @@ func RenderFile(title, author, fileName string) (string, error) {
 	return RenderSpec(title, author)
 }
 
+// RenderCustom renders a project-provided template for a new spec or plan
+// file with the same data as the built-in spec template.
+func RenderCustom(tmpl, title, author string) (string, error) {
+	data := SpecData{
+		Title:        title,
+		Author:       author,
+		CreationDate: time.Now().Format("2006-01-02"),
+	}
+
+	return template.RenderTemplate(tmpl, data)
+}
+
 // RenderPlan renders a complete plan file from the standard plan template.
 func RenderPlan(title, author string) string {
 	return fmt.Sprintf(`---
@@ -0,0 +1,218 @@
+// Package plan parses PLAN.md files into pull request slices and their tasks.
+package plan
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	specpkg "github.com/specture-system/specture/internal/spec"
+	"github.com/yuin/goldmark/ast"
+	extast "github.com/yuin/goldmark/extension/ast"
+)
+
+// Filename is the name of a plan file.
+const Filename = "PLAN.md"
+
+// notesHeading is the heading of the implementation notes section.
+const notesHeading = "Implementation Notes"
+
+// sliceHeadingPattern matches slice headings such as "PR 2: Follow-up slice".
+var sliceHeadingPattern = regexp.MustCompile(`(?i)^PR\s+(\d+)\s*(?:[:.\-–—]\s*)?(.*)$`)
+
+// Plan is a parsed PLAN.md.
+type Plan struct {
+	Path  string
+	Title string
+	// SpecLink is the destination of the first link to a SPEC.md, or empty
+	// when the plan does not link to one.
+	SpecLink string
+	// Slices lists the "### PR N" slices in document order.
+	Slices []Slice
+	// Notes holds the markdown body of the Implementation Notes section,
+	// without its heading.
+	Notes string
+}
+
+// Slice is one pull request slice of a plan.
+type Slice struct {
+	Number int
+	Title  string
+	// Heading is the full heading text, such as "PR 1: First slice".
+	Heading string
+	Tasks   []Task
+	// Markdown is the slice's section, heading line included.
+	Markdown string
+}
+
+// Task is a list item within a slice. Items of nested lists are included
+// with a greater Depth.
+type Task struct {
+	Text  string
+	Depth int
+	// Checkbox reports whether the item is a task list item ("- [ ]").
+	Checkbox bool
+	Done     bool
+}
+
+// Progress counts the slice's checkbox tasks.
+func (s Slice) Progress() specpkg.Progress {
+	var progress specpkg.Progress
+	for _, task := range s.Tasks {
+		if task.Checkbox {
+			progress.Total++
+			if task.Done {
+				progress.Done++
+			}
+		}
+	}
+	return progress
+}
+
+// Complete reports whether every checkbox task in the slice is checked.
+// Slices without checkbox tasks are never complete, since nothing records
+// that their work is done.
+func (s Slice) Complete() bool {
+	progress := s.Progress()
+	return progress.Total > 0 && progress.Done == progress.Total
+}
+
+// Progress counts the checkbox tasks across all slices.
+func (p *Plan) Progress() specpkg.Progress {
+	var progress specpkg.Progress
+	for _, slice := range p.Slices {
+		progress = progress.Add(slice.Progress())
+	}
+	return progress
+}
+
+// Next returns the first slice that is not complete, or nil when every slice
+// is complete.
+func (p *Plan) Next() *Slice {
+	for i := range p.Slices {
+		if !p.Slices[i].Complete() {
+			return &p.Slices[i]
+		}
+	}
+	return nil
+}
+
+// Parse reads and parses the plan file at path.
+func Parse(path string) (*Plan, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read plan: %w", err)
+	}
+	return ParseContent(path, content)
+}
+
+// ParseContent parses plan content. Slices are the H3 headings of the form
+// "PR N: title"; their tasks are the list items up to the next heading at H3
+// or above.
+func ParseContent(path string, content []byte) (*Plan, error) {
+	doc, _ := specpkg.ParseMarkdown(content)
+	spans := make(map[*ast.Heading]specpkg.HeadingSpan)
+	for _, span := range specpkg.HeadingSpans(doc, content) {
+		spans[span.Heading] = span
+	}
+
+	plan := &Plan{Path: path}
+	var current *Slice
+	for n := doc.FirstChild(); n != nil; n = n.NextSibling() {
+		heading, ok := n.(*ast.Heading)
+		if ok && heading.Level <= 3 {
+			current = nil
+			title := strings.TrimSpace(specpkg.InlineText(heading, content))
+			span, located := spans[heading]
+			switch {
+			case heading.Level == 1 && plan.Title == "":
+				plan.Title = title
+			case heading.Level == 2 && strings.EqualFold(title, notesHeading) && located:
+				plan.Notes = sectionBody(span.Markdown(content))
+			case heading.Level == 3 && located:
+				match := sliceHeadingPattern.FindStringSubmatch(title)
+				if match == nil {
+					continue
+				}
+				number, _ := strconv.Atoi(match[1])
+				plan.Slices = append(plan.Slices, Slice{
+					Number:   number,
+					Title:    strings.TrimSpace(match[2]),
+					Heading:  title,
+					Markdown: string(span.Markdown(content)),
+				})
+				current = &plan.Slices[len(plan.Slices)-1]
+			}
+			continue
+		}
+
+		if plan.SpecLink == "" {
+			plan.SpecLink = findSpecLink(n)
+		}
+		if list, ok := n.(*ast.List); ok && current != nil {
+			current.Tasks = appendTasks(current.Tasks, list, content, 0)
+		}
+	}
+
+	return plan, nil
+}
+
+// appendTasks appends the items of list and of its nested lists to tasks.
+func appendTasks(tasks []Task, list *ast.List, source []byte, depth int) []Task {
+	for item := list.FirstChild(); item != nil; item = item.NextSibling() {
+		task := Task{Depth: depth}
+		var nested []*ast.List
+		for child := item.FirstChild(); child != nil; child = child.NextSibling() {
+			if sublist, ok := child.(*ast.List); ok {
+				nested = append(nested, sublist)
+				continue
+			}
+			if task.Text == "" {
+				task.Text = strings.TrimSpace(specpkg.InlineText(child, source))
+			}
+			if box, ok := child.FirstChild().(*extast.TaskCheckBox); ok && !task.Checkbox {
+				task.Checkbox = true
+				task.Done = box.IsChecked
+			}
+		}
+		tasks = append(tasks, task)
+		for _, sublist := range nested {
+			tasks = appendTasks(tasks, sublist, source, depth+1)
+		}
+	}
+	return tasks
+}
+
+// findSpecLink returns the destination of the first link within n that
+// points at a SPEC.md file.
+func findSpecLink(n ast.Node) string {
+	var destination string
+	ast.Walk(n, func(child ast.Node, entering bool) (ast.WalkStatus, error) {
+		link, ok := child.(*ast.Link)
+		if !entering || !ok {
+			return ast.WalkContinue, nil
+		}
+		target := string(link.Destination)
+		if i := strings.IndexAny(target, "#?"); i >= 0 {
+			target = target[:i]
+		}
+		if path.Base(target) == "SPEC.md" {
+			destination = string(link.Destination)
+			return ast.WalkStop, nil
+		}
+		return ast.WalkSkipChildren, nil
+	})
+	return destination
+}
+
+// sectionBody drops the heading line of a section's markdown.
+func sectionBody(section []byte) string {
+	if i := bytes.IndexByte(section, '\n'); i >= 0 {
+		section = section[i+1:]
+	}
+	return strings.TrimSpace(string(section))
+}
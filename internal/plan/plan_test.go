@@ -0,0 +1,134 @@
+package plan
+
+import (
+	"testing"
+
+	specpkg "github.com/specture-system/specture/internal/spec"
+)
+
+const samplePlan = `---
+status: in-progress
+---
+
+# Feature Plan
+
+Implement [Feature](/specs/004-feature/SPEC.md#goals) in small chunks.
+
+## Pull Request Plan
+
+### PR 1: Parser
+
+- [x] Parse ` + "`slices`" + `
+- [X] Add tests
+  - [x] Nested case
+
+### PR 2 - Output
+
+- [ ] Print slices
+- Plain bullet
+
+### Not a slice
+
+- Ignored
+
+### PR 3: Docs
+
+- Write docs
+
+## Implementation Notes
+
+- Keep it simple.
+`
+
+func TestParseContent(t *testing.T) {
+	plan, err := ParseContent("specs/004-feature/PLAN.md", []byte(samplePlan))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if plan.Title != "Feature Plan" {
+		t.Errorf("expected title %q, got %q", "Feature Plan", plan.Title)
+	}
+	if plan.SpecLink != "/specs/004-feature/SPEC.md#goals" {
+		t.Errorf("unexpected spec link %q", plan.SpecLink)
+	}
+	if plan.Notes != "- Keep it simple." {
+		t.Errorf("unexpected notes %q", plan.Notes)
+	}
+
+	if len(plan.Slices) != 3 {
+		t.Fatalf("expected 3 slices, got %+v", plan.Slices)
+	}
+	first := plan.Slices[0]
+	if first.Number != 1 || first.Title != "Parser" || first.Heading != "PR 1: Parser" {
+		t.Errorf("unexpected first slice %+v", first)
+	}
+	wantTasks := []Task{
+		{Text: "Parse slices", Checkbox: true, Done: true},
+		{Text: "Add tests", Checkbox: true, Done: true},
+		{Text: "Nested case", Depth: 1, Checkbox: true, Done: true},
+	}
+	if len(first.Tasks) != len(wantTasks) {
+		t.Fatalf("expected %d tasks, got %+v", len(wantTasks), first.Tasks)
+	}
+	for i, want := range wantTasks {
+		if first.Tasks[i] != want {
+			t.Errorf("task %d: expected %+v, got %+v", i, want, first.Tasks[i])
+		}
+	}
+
+	second := plan.Slices[1]
+	if second.Number != 2 || second.Title != "Output" {
+		t.Errorf("expected PR 2 titled Output, got %+v", second)
+	}
+	if second.Markdown != "### PR 2 - Output\n\n- [ ] Print slices\n- Plain bullet\n" {
+		t.Errorf("unexpected slice markdown %q", second.Markdown)
+	}
+	if len(plan.Slices[2].Tasks) != 1 {
+		t.Errorf("expected the non-slice heading to end PR 2, got %+v", second.Tasks)
+	}
+}
+
+func TestParseContent_DuplicateSliceHeadings(t *testing.T) {
+	content := "# Plan\n\n### PR 1: Tests\n\n- [x] First\n\n### PR 1: Tests\n\n- [ ] Second\n"
+	plan, err := ParseContent("PLAN.md", []byte(content))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(plan.Slices) != 2 {
+		t.Fatalf("expected 2 slices, got %+v", plan.Slices)
+	}
+	for i, want := range []string{
+		"### PR 1: Tests\n\n- [x] First\n",
+		"### PR 1: Tests\n\n- [ ] Second\n",
+	} {
+		if plan.Slices[i].Markdown != want {
+			t.Errorf("slice %d markdown = %q, want %q", i, plan.Slices[i].Markdown, want)
+		}
+	}
+}
+
+func TestPlan_ProgressAndNext(t *testing.T) {
+	plan, err := ParseContent("PLAN.md", []byte(samplePlan))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := plan.Progress(); got != (specpkg.Progress{Done: 3, Total: 4}) {
+		t.Errorf("expected progress 3/4, got %s", got)
+	}
+	if !plan.Slices[0].Complete() || plan.Slices[1].Complete() {
+		t.Errorf("expected only PR 1 to be complete")
+	}
+	if plan.Slices[2].Complete() {
+		t.Errorf("expected a slice without checkboxes to be incomplete")
+	}
+	if next := plan.Next(); next == nil || next.Number != 2 {
+		t.Errorf("expected PR 2 to be next, got %+v", next)
+	}
+
+	plan.Slices = plan.Slices[:1]
+	if next := plan.Next(); next != nil {
+		t.Errorf("expected no next slice when all are complete, got %+v", next)
+	}
+}
@@ -0,0 +1,145 @@
+package query
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	specpkg "github.com/specture-system/specture/internal/spec"
+)
+
+// specFields exposes a spec's metadata to expressions.
+type specFields struct {
+	spec   *specpkg.SpecInfo
+	orders Orders
+}
+
+// Orders ranks the values of the status and priority fields, so that
+// comparisons agree with spec.SortSpecs.
+type Orders struct {
+	// Statuses lists statuses in workflow order.
+	Statuses []string
+	// Priorities lists priorities highest first, so "priority < medium"
+	// matches the priorities above medium.
+	Priorities []string
+}
+
+// SpecFields returns the fields of spec: ref, name, status, assignee,
+// labels, priority, milestone, author, creation_date, approved_by,
+// approval_date, path, depends_on, blocks, supersedes, superseded_by,
+// has_plan, progress (the percentage of checked tasks, unset without tasks),
+// created_at, created_by, updated_at, and updated_by (from git history, with
+// times in RFC 3339 UTC), and any other frontmatter key. Statuses and
+// priorities order by their position in orders.
+func SpecFields(spec *specpkg.SpecInfo, orders Orders) Fields {
+	return specFields{spec: spec, orders: orders}
+}
+
+func (f specFields) Order(field string) []string {
+	switch field {
+	case "status":
+		return f.orders.Statuses
+	case "priority":
+		return f.orders.Priorities
+	}
+	return nil
+}
+
+func (f specFields) Lookup(field string) ([]string, bool) {
+	spec := f.spec
+	scalar := func(value string) ([]string, bool) {
+		return []string{value}, value != ""
+	}
+	list := func(values []string) ([]string, bool) {
+		return values, len(values) > 0
+	}
+
+	switch field {
+	case "ref":
+		return scalar(spec.FullRef)
+	case "name":
+		return scalar(spec.Name)
+	case "status":
+		return scalar(spec.Status)
+	case "assignee":
+		return list(spec.Assignees)
+	case "labels":
+		return list(spec.Labels)
+	case "priority":
+		return scalar(spec.Priority)
+	case "milestone":
+		return scalar(spec.Milestone)
+	case "author":
+		return scalar(spec.Author)
+	case "creation_date":
+		return scalar(spec.CreationDate)
+	case "approved_by":
+		return scalar(spec.ApprovedBy)
+	case "approval_date":
+		return scalar(spec.ApprovalDate)
+	case "path":
+		return scalar(spec.Path)
+	case "depends_on":
+		return list(spec.DependsOn)
+	case "blocks":
+		return list(spec.Blocks)
+	case "supersedes":
+		return list(spec.Supersedes)
+	case "superseded_by":
+		return list(spec.SupersededBy)
+	case "has_plan":
+		return scalar(strconv.FormatBool(spec.HasPlan))
+	case "progress":
+		progress := spec.TotalProgress()
+		if progress.Total == 0 {
+			return nil, false
+		}
+		return scalar(strconv.Itoa(progress.Percent()))
+	case "created_at":
+		return timestamp(spec.CreatedAt)
+	case "created_by":
+		return scalar(spec.CreatedBy)
+	case "updated_at":
+		return timestamp(spec.UpdatedAt)
+	case "updated_by":
+		return scalar(spec.UpdatedBy)
+	}
+
+	for key, value := range spec.Extra {
+		if CanonicalField(key) == field {
+			return extraValues(value)
+		}
+	}
+	return nil, false
+}
+
+// timestamp returns t in RFC 3339 UTC, which orders as text, or no value
+// for the zero time.
+func timestamp(t time.Time) ([]string, bool) {
+	if t.IsZero() {
+		return nil, false
+	}
+	return []string{t.UTC().Format(time.RFC3339)}, true
+}
+
+// extraValues converts a decoded frontmatter value to strings. Scalars keep
+// their YAML source text, except numbers and booleans, so an unquoted date
+// compares as written. Lists yield one string per scalar item; mappings are
+// not comparable.
+func extraValues(value any) ([]string, bool) {
+	switch v := value.(type) {
+	case nil:
+		return nil, false
+	case []any:
+		var values []string
+		for _, item := range v {
+			if item != nil {
+				values = append(values, fmt.Sprint(item))
+			}
+		}
+		return values, len(values) > 0
+	case map[string]any:
+		return nil, false
+	}
+	return []string{fmt.Sprint(value)}, true
+}
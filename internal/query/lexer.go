@@ -0,0 +1,104 @@
+package query
+
+import (
+	"fmt"
+	"strings"
+)
+
+// tokenKind classifies a lexical token of a filter expression.
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenWord
+	tokenString
+	tokenOperator
+	tokenLParen
+	tokenRParen
+	tokenComma
+)
+
+// token is a lexical token and its byte offset in the expression.
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+// describe names the token for error messages.
+func (t token) describe() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of expression"
+	case tokenString:
+		return fmt.Sprintf("string %q", t.text)
+	default:
+		return fmt.Sprintf("%q", t.text)
+	}
+}
+
+// keyword reports whether the token is the unquoted keyword word.
+func (t token) keyword(word string) bool {
+	return t.kind == tokenWord && strings.EqualFold(t.text, word)
+}
+
+// operators lists the comparison operators, longest first so that "<=" is
+// not read as "<".
+var operators = []string{"!=", "<=", ">=", "=", "<", ">", "~"}
+
+// wordBreaks are the characters that end an unquoted word.
+const wordBreaks = " \t\r\n()=,!<>~\"'"
+
+// lex splits an expression into tokens, ending with a tokenEOF.
+func lex(input string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(input); {
+		c := input[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			i++
+		case c == '(':
+			tokens = append(tokens, token{kind: tokenLParen, text: "(", pos: i})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{kind: tokenRParen, text: ")", pos: i})
+			i++
+		case c == ',':
+			tokens = append(tokens, token{kind: tokenComma, text: ",", pos: i})
+			i++
+		case c == '"' || c == '\'':
+			end := strings.IndexByte(input[i+1:], c)
+			if end < 0 {
+				return nil, &ParseError{Pos: i, Message: "unterminated string"}
+			}
+			tokens = append(tokens, token{kind: tokenString, text: input[i+1 : i+1+end], pos: i})
+			i += end + 2
+		default:
+			if op := matchOperator(input[i:]); op != "" {
+				tokens = append(tokens, token{kind: tokenOperator, text: op, pos: i})
+				i += len(op)
+				continue
+			}
+			if c == '!' {
+				return nil, &ParseError{Pos: i, Message: `unexpected "!" (use "not" or "!=")`}
+			}
+			end := i
+			for end < len(input) && !strings.ContainsRune(wordBreaks, rune(input[end])) {
+				end++
+			}
+			tokens = append(tokens, token{kind: tokenWord, text: input[i:end], pos: i})
+			i = end
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, pos: len(input)}), nil
+}
+
+// matchOperator returns the operator at the start of s, or "".
+func matchOperator(s string) string {
+	for _, op := range operators {
+		if strings.HasPrefix(s, op) {
+			return op
+		}
+	}
+	return ""
+}
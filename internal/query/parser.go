@@ -0,0 +1,238 @@
+package query
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Parse parses a filter expression. Field names are canonicalized with
+// CanonicalField. Syntax errors are returned as *ParseError.
+//
+// Grammar:
+//
+//	expr       = and { "or" and }
+//	and        = unary { "and" unary }
+//	unary      = "not" unary | "(" expr ")" | comparison
+//	comparison = field op value | field ["not"] "in" "(" value { "," value } ")"
+func Parse(input string) (Expr, error) {
+	tokens, err := lex(input)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	if p.peek().kind == tokenEOF {
+		return nil, &ParseError{Pos: 0, Message: "empty expression"}
+	}
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if next := p.peek(); next.kind != tokenEOF {
+		return nil, p.errorf(next, `expected "and", "or", or end of expression, found %s`, next.describe())
+	}
+	return expr, nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) errorf(t token, format string, args ...any) error {
+	return &ParseError{Pos: t.pos, Message: fmt.Sprintf(format, args...)}
+}
+
+func (p *parser) parseOr() (Expr, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().keyword("or") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &Or{Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (Expr, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek().keyword("and") {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = &And{Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (Expr, error) {
+	t := p.peek()
+	switch {
+	case t.keyword("not"):
+		p.next()
+		expr, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &Not{Expr: expr}, nil
+	case t.kind == tokenLParen:
+		p.next()
+		expr, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if closing := p.next(); closing.kind != tokenRParen {
+			return nil, p.errorf(closing, `expected ")" to close "(" at column %d, found %s`, t.pos+1, closing.describe())
+		}
+		return expr, nil
+	}
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (Expr, error) {
+	field := p.next()
+	if field.kind != tokenWord || isKeyword(field.text) {
+		return nil, p.errorf(field, "expected a field name, found %s", field.describe())
+	}
+	name := CanonicalField(field.text)
+
+	op := p.next()
+	switch {
+	case op.kind == tokenOperator:
+		value, err := p.parseValue(op)
+		if err != nil {
+			return nil, err
+		}
+		return &Comparison{Field: name, Op: op.text, Values: []string{value}}, nil
+	case op.keyword("in"):
+		values, err := p.parseList(op)
+		if err != nil {
+			return nil, err
+		}
+		return &Comparison{Field: name, Op: "in", Values: values}, nil
+	case op.keyword("not") && p.peek().keyword("in"):
+		in := p.next()
+		values, err := p.parseList(in)
+		if err != nil {
+			return nil, err
+		}
+		return &Not{Expr: &Comparison{Field: name, Op: "in", Values: values}}, nil
+	}
+	return nil, p.errorf(op, "expected an operator (=, !=, <, <=, >, >=, ~, in, not in) after %q, found %s", field.text, op.describe())
+}
+
+// parseValue reads the value following after, an operator or comma.
+func (p *parser) parseValue(after token) (string, error) {
+	value := p.next()
+	if value.kind == tokenString || (value.kind == tokenWord && !isKeyword(value.text)) {
+		return value.text, nil
+	}
+	return "", p.errorf(value, "expected a value after %q, found %s", after.text, value.describe())
+}
+
+// parseList reads a parenthesized, comma-separated list of values.
+func (p *parser) parseList(in token) ([]string, error) {
+	open := p.next()
+	if open.kind != tokenLParen {
+		return nil, p.errorf(open, `expected "(" after %q, found %s`, in.text, open.describe())
+	}
+	var values []string
+	separator := open
+	for {
+		value, err := p.parseValue(separator)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+		separator = p.next()
+		switch separator.kind {
+		case tokenComma:
+			continue
+		case tokenRParen:
+			return values, nil
+		}
+		return nil, p.errorf(separator, `expected "," or ")" in list, found %s`, separator.describe())
+	}
+}
+
+// isKeyword reports whether word is reserved by the expression syntax.
+func isKeyword(word string) bool {
+	switch strings.ToLower(word) {
+	case "and", "or", "not", "in":
+		return true
+	}
+	return false
+}
+
+// fieldAliases maps alternative field names to canonical ones.
+var fieldAliases = map[string]string{
+	"title":     "name",
+	"label":     "labels",
+	"assignees": "assignee",
+	"created":   "creation_date",
+	"approved":  "approval_date",
+	"depends":   "depends_on",
+	"updated":   "updated_at",
+}
+
+// CanonicalField returns the canonical name of a field: lower case, with
+// "-" read as "_", and aliases such as label and created resolved to labels
+// and creation_date.
+func CanonicalField(name string) string {
+	name = strings.ReplaceAll(strings.ToLower(name), "-", "_")
+	if canonical, ok := fieldAliases[name]; ok {
+		return canonical
+	}
+	return name
+}
+
+// dottedNumber matches values ordered segment by segment, such as refs and
+// plain integers.
+var dottedNumber = regexp.MustCompile(`^\d+(\.\d+)*$`)
+
+// compareValues orders two values: dotted numbers segment by segment, other
+// numbers numerically, and anything else as case-insensitive text, which
+// orders YYYY-MM-DD dates chronologically.
+func compareValues(a, b string) int {
+	if dottedNumber.MatchString(a) && dottedNumber.MatchString(b) {
+		aParts, bParts := strings.Split(a, "."), strings.Split(b, ".")
+		for i := 0; i < len(aParts) && i < len(bParts); i++ {
+			aNum, _ := strconv.Atoi(aParts[i])
+			bNum, _ := strconv.Atoi(bParts[i])
+			if aNum != bNum {
+				return cmp.Compare(aNum, bNum)
+			}
+		}
+		return cmp.Compare(len(aParts), len(bParts))
+	}
+	aNum, aErr := strconv.ParseFloat(a, 64)
+	bNum, bErr := strconv.ParseFloat(b, 64)
+	if aErr == nil && bErr == nil {
+		return cmp.Compare(aNum, bNum)
+	}
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
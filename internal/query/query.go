@@ -0,0 +1,165 @@
+// Package query parses and evaluates filter expressions over spec metadata,
+// such as:
+//
+//	status in (draft, approved) and assignee = "Alice" and not label = docs
+//
+// Expressions combine comparisons with and, or, not, and parentheses. A
+// comparison is a field, an operator (=, !=, <, <=, >, >=, ~ for "contains",
+// in, not in), and a value or parenthesized list of values. Values are bare
+// words or quoted strings.
+package query
+
+import (
+	"fmt"
+	"strings"
+
+	specpkg "github.com/specture-system/specture/internal/spec"
+)
+
+// Expr is a parsed filter expression.
+type Expr interface {
+	// Match reports whether spec satisfies the expression.
+	Match(spec Fields) bool
+	// References reports whether the expression compares field, given by
+	// its canonical name.
+	References(field string) bool
+	String() string
+}
+
+// Fields resolves field names to the values of one spec. Lookup returns
+// the values of a field, several for list fields, and false when the spec
+// does not set it. Order returns the ranking of a field whose values order
+// by position rather than by value, or nil.
+type Fields interface {
+	Lookup(field string) ([]string, bool)
+	Order(field string) []string
+}
+
+// ParseError reports a syntax error at a byte offset of the expression.
+type ParseError struct {
+	Pos     int
+	Message string
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%s at column %d", e.Message, e.Pos+1)
+}
+
+// Pointer returns input with a caret marking the error position on the line
+// below, for showing alongside the error.
+func (e *ParseError) Pointer(input string) string {
+	return input + "\n" + strings.Repeat(" ", min(e.Pos, len(input))) + "^"
+}
+
+// And matches when both operands match.
+type And struct{ Left, Right Expr }
+
+// Or matches when either operand matches.
+type Or struct{ Left, Right Expr }
+
+// Not matches when its operand does not.
+type Not struct{ Expr Expr }
+
+// Comparison compares a field with one value, or with a list of values for
+// the in operator.
+type Comparison struct {
+	Field  string
+	Op     string
+	Values []string
+}
+
+func (e *And) Match(spec Fields) bool { return e.Left.Match(spec) && e.Right.Match(spec) }
+func (e *Or) Match(spec Fields) bool  { return e.Left.Match(spec) || e.Right.Match(spec) }
+func (e *Not) Match(spec Fields) bool { return !e.Expr.Match(spec) }
+
+func (e *And) References(field string) bool {
+	return e.Left.References(field) || e.Right.References(field)
+}
+func (e *Or) References(field string) bool {
+	return e.Left.References(field) || e.Right.References(field)
+}
+func (e *Not) References(field string) bool { return e.Expr.References(field) }
+func (e *Comparison) References(field string) bool {
+	return e.Field == field
+}
+
+func (e *And) String() string { return "(" + e.Left.String() + " and " + e.Right.String() + ")" }
+func (e *Or) String() string  { return "(" + e.Left.String() + " or " + e.Right.String() + ")" }
+func (e *Not) String() string { return "not " + e.Expr.String() }
+func (e *Comparison) String() string {
+	quoted := make([]string, len(e.Values))
+	for i, value := range e.Values {
+		quoted[i] = fmt.Sprintf("%q", value)
+	}
+	if e.Op == "in" {
+		return e.Field + " in (" + strings.Join(quoted, ", ") + ")"
+	}
+	return e.Field + " " + e.Op + " " + quoted[0]
+}
+
+// Match reports whether any value of the field satisfies the comparison.
+// A field the spec does not set compares as a single empty value, so
+// "owner != alice" matches specs without an owner. != is the negation of =,
+// so it matches only when no value of a list field is equal.
+func (e *Comparison) Match(spec Fields) bool {
+	values, ok := spec.Lookup(e.Field)
+	if !ok || len(values) == 0 {
+		values = []string{""}
+	}
+	if e.Op == "!=" {
+		return !(&Comparison{Field: e.Field, Op: "=", Values: e.Values}).Match(spec)
+	}
+	order := spec.Order(e.Field)
+	for _, value := range values {
+		for _, want := range e.Values {
+			if compare(e.Op, value, want, order) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// compare applies op to a field value and an expression value, ordering
+// them by their position in order when it is set.
+func compare(op, value, want string, order []string) bool {
+	switch op {
+	case "=", "in":
+		return strings.EqualFold(value, want)
+	case "~":
+		return strings.Contains(strings.ToLower(value), strings.ToLower(want))
+	}
+	if value == "" {
+		// Unset values have no order.
+		return false
+	}
+	c := compareValues(value, want)
+	if order != nil {
+		c = compareRanked(order, value, want)
+	}
+	switch op {
+	case "<":
+		return c < 0
+	case "<=":
+		return c <= 0
+	case ">":
+		return c > 0
+	case ">=":
+		return c >= 0
+	}
+	return false
+}
+
+// compareRanked orders two values by their position in order, ignoring
+// case, as spec.SortSpecs does.
+func compareRanked(order []string, a, b string) int {
+	listed := func(value string) string {
+		for _, item := range order {
+			if strings.EqualFold(item, value) {
+				return item
+			}
+		}
+		return strings.ToLower(value)
+	}
+	return specpkg.CompareRanked(order, listed(a), listed(b))
+}
@@ -0,0 +1,148 @@
+package query
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	specpkg "github.com/specture-system/specture/internal/spec"
+)
+
+func TestParse_String(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{`status = draft`, `status = "draft"`},
+		{`Status IN (draft, "in progress")`, `status in ("draft", "in progress")`},
+		{`a = 1 or b = 2 and c = 3`, `(a = "1" or (b = "2" and c = "3"))`},
+		{`(a = 1 or b = 2) and c = 3`, `((a = "1" or b = "2") and c = "3")`},
+		{`not label = docs`, `not labels = "docs"`},
+		{`status not in (completed)`, `not status in ("completed")`},
+		{`created >= 2026-01-01 and title ~ 'cli'`, `(creation_date >= "2026-01-01" and name ~ "cli")`},
+	}
+	for _, tt := range tests {
+		expr, err := Parse(tt.input)
+		if err != nil {
+			t.Errorf("Parse(%q): unexpected error: %v", tt.input, err)
+			continue
+		}
+		if got := expr.String(); got != tt.want {
+			t.Errorf("Parse(%q) = %s, want %s", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		input   string
+		message string
+		column  int
+	}{
+		{``, "empty expression", 1},
+		{`status = and`, `expected a value after "=", found "and"`, 10},
+		{`status draft`, `expected an operator (=, !=, <, <=, >, >=, ~, in, not in) after "status", found "draft"`, 8},
+		{`status in draft`, `expected "(" after "in", found "draft"`, 11},
+		{`status in (draft approved)`, `expected "," or ")" in list, found "approved"`, 18},
+		{`(status = draft`, `expected ")" to close "(" at column 1, found end of expression`, 16},
+		{`status = draft approved`, `expected "and", "or", or end of expression, found "approved"`, 16},
+		{`name = "open`, "unterminated string", 8},
+		{`!status = draft`, `unexpected "!" (use "not" or "!=")`, 1},
+		{`= draft`, `expected a field name, found "="`, 1},
+	}
+	for _, tt := range tests {
+		_, err := Parse(tt.input)
+		var parseErr *ParseError
+		if !errors.As(err, &parseErr) {
+			t.Errorf("Parse(%q): expected a ParseError, got %v", tt.input, err)
+			continue
+		}
+		if parseErr.Message != tt.message || parseErr.Pos+1 != tt.column {
+			t.Errorf("Parse(%q): got %q at column %d, want %q at column %d", tt.input, parseErr.Message, parseErr.Pos+1, tt.message, tt.column)
+		}
+	}
+}
+
+func TestParseError_Pointer(t *testing.T) {
+	_, err := Parse(`status = and`)
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("expected a ParseError, got %v", err)
+	}
+	if got := parseErr.Pointer(`status = and`); got != "status = and\n         ^" {
+		t.Errorf("unexpected pointer:\n%s", got)
+	}
+}
+
+func TestMatch(t *testing.T) {
+	spec := &specpkg.SpecInfo{
+		FullRef:      "4.10",
+		Name:         "List Command",
+		Status:       "in-progress",
+		Assignees:    []string{"Alice Example", "Bob"},
+		Labels:       []string{"cli", "docs"},
+		Priority:     "high",
+		CreationDate: "2026-02-03",
+		HasPlan:      true,
+		Progress:     specpkg.Progress{Done: 1, Total: 4},
+		Extra:        map[string]any{"team": "core", "review-by": []any{"carol", "dave"}, "points": 5},
+	}
+
+	tests := []struct {
+		input string
+		want  bool
+	}{
+		{`status in (draft, in-progress)`, true},
+		{`status = IN-PROGRESS`, true},
+		{`status not in (draft, approved)`, true},
+		{`assignee = "alice example"`, true},
+		{`assignee = alice`, false},
+		{`assignee ~ alice`, true},
+		{`label = docs`, true},
+		{`not label = docs`, false},
+		{`labels != validation`, true},
+		{`labels != cli`, false},
+		{`created > 2026-01-01 and created < 2026-03-01`, true},
+		{`ref > 4.9`, true},
+		{`ref < 10`, true},
+		{`progress >= 25 and has_plan = true`, true},
+		{`team = core`, true},
+		{`review_by = dave and points > 4`, true},
+		{`owner != alice`, true},
+		{`owner = ""`, true},
+		{`milestone < v1`, false},
+		{`priority = low or (priority = high and status != completed)`, true},
+		{`priority < low and priority > critical`, true},
+		{`priority <= HIGH and priority >= high`, true},
+		{`priority < medium and priority < high`, false},
+		{`status > approved and status < completed`, true},
+		{`status > draft and status < approved`, false},
+	}
+	orders := Orders{
+		Statuses:   []string{"draft", "approved", "in-progress", "completed"},
+		Priorities: []string{"critical", "high", "medium", "low"},
+	}
+	for _, tt := range tests {
+		expr, err := Parse(tt.input)
+		if err != nil {
+			t.Errorf("Parse(%q): unexpected error: %v", tt.input, err)
+			continue
+		}
+		if got := expr.Match(SpecFields(spec, orders)); got != tt.want {
+			t.Errorf("%s: got %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestReferences(t *testing.T) {
+	expr, err := Parse(`not (Status = draft or label = cli)`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !expr.References("status") || !expr.References("labels") || expr.References("assignee") {
+		t.Errorf("unexpected references for %s", expr)
+	}
+	if !strings.Contains(expr.String(), "status") {
+		t.Errorf("expected canonical field names, got %s", expr)
+	}
+}
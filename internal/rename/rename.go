@@ -25,8 +25,9 @@ type LinkUpdate struct {
 	NewLink string
 }
 
-// Plan creates a rename plan for a spec without executing it.
-func Plan(specsDir string, specRef string, newSlug string) (*RenameResult, error) {
+// Plan creates a rename plan for a spec without executing it. padding is the
+// zero-padded width of the number in the new directory name.
+func Plan(specsDir string, specRef string, newSlug string, padding int) (*RenameResult, error) {
 	// Find the spec directory by reference.
 	oldPath, err := specpkg.ResolvePath(specsDir, specRef)
 	if err != nil {
@@ -47,7 +48,7 @@ func Plan(specsDir string, specRef string, newSlug string) (*RenameResult, error
 		return nil, fmt.Errorf("failed to parse spec: %w", err)
 	}
 
-	newDirName := fmt.Sprintf("%03d-%s", info.Number, newSlug)
+	newDirName := fmt.Sprintf("%0*d-%s", padding, info.Number, newSlug)
 	newDir := filepath.Join(parentDir, newDirName)
 	newPath := filepath.Join(newDir, filepath.Base(oldPath))
 
@@ -43,7 +43,7 @@ func TestPlan_BasicRename(t *testing.T) {
 		"003-old-name/SPEC.md": "---\nnumber: 3\n---\n\n# Status Command\n\n## Task List\n",
 	})
 
-	result, err := Plan(dir, "3", "status-command")
+	result, err := Plan(dir, "3", "status-command", 3)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -61,7 +61,7 @@ func TestPlan_EmptySlugError(t *testing.T) {
 		"003-status-command/SPEC.md": "---\nnumber: 3\n---\n\n# Status Command\n\n## Task List\n",
 	})
 
-	_, err := Plan(dir, "3", "")
+	_, err := Plan(dir, "3", "", 3)
 	if err == nil {
 		t.Fatal("expected error for empty slug")
 	}
@@ -72,7 +72,7 @@ func TestPlan_CustomSlug(t *testing.T) {
 		"003-old-name/SPEC.md": "---\nnumber: 3\n---\n\n# Status Command\n\n## Task List\n",
 	})
 
-	result, err := Plan(dir, "3", "spec-status")
+	result, err := Plan(dir, "3", "spec-status", 3)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -87,7 +87,7 @@ func TestPlan_PlanFileRename(t *testing.T) {
 		"003-old-name/PLAN.md": "---\nnumber: 3\n---\n\n# Status Command\n\n## Task List\n",
 	})
 
-	result, err := Plan(dir, "3", "status-command")
+	result, err := Plan(dir, "3", "status-command", 3)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -106,7 +106,7 @@ func TestPlan_FindsLinkReferences(t *testing.T) {
 		"005-list-command/SPEC.md": "---\nnumber: 5\n---\n\n# List Command\n\nSee [status](/specs/003-old-name/SPEC.md).\n\n## Task List\n",
 	})
 
-	result, err := Plan(dir, "3", "status-command")
+	result, err := Plan(dir, "3", "status-command", 3)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -134,7 +134,7 @@ func TestPlan_SameNameError(t *testing.T) {
 		"003-status-command/SPEC.md": "---\nnumber: 3\n---\n\n# Status Command\n\n## Task List\n",
 	})
 
-	_, err := Plan(dir, "3", "status-command")
+	_, err := Plan(dir, "3", "status-command", 3)
 	if err == nil {
 		t.Fatal("expected error for same name")
 	}
@@ -146,7 +146,7 @@ func TestPlan_TargetExistsError(t *testing.T) {
 		"003-status-command/SPEC.md": "---\nnumber: 99\n---\n\n# Other\n\n## Task List\n",
 	})
 
-	_, err := Plan(dir, "3", "status-command")
+	_, err := Plan(dir, "3", "status-command", 3)
 	if err == nil {
 		t.Fatal("expected error when target exists")
 	}
@@ -160,7 +160,7 @@ func TestExecute_RenamesFile(t *testing.T) {
 		"003-old-name/SPEC.md": "---\nnumber: 3\n---\n\n# Status Command\n\n## Task List\n",
 	})
 
-	result, err := Plan(dir, "3", "status-command")
+	result, err := Plan(dir, "3", "status-command", 3)
 	if err != nil {
 		t.Fatalf("Plan error: %v", err)
 	}
@@ -186,7 +186,7 @@ func TestExecute_UpdatesLinks(t *testing.T) {
 		"005-list-command/SPEC.md": "---\nnumber: 5\n---\n\n# List\n\nSee [status](/specs/003-old-name/SPEC.md).\n\n## Task List\n",
 	})
 
-	result, err := Plan(dir, "3", "status-command")
+	result, err := Plan(dir, "3", "status-command", 3)
 	if err != nil {
 		t.Fatalf("Plan error: %v", err)
 	}
@@ -215,7 +215,7 @@ func TestPlan_DryRunDoesNotModify(t *testing.T) {
 	})
 
 	// Plan only, don't execute
-	_, err := Plan(dir, "3", "status-command")
+	_, err := Plan(dir, "3", "status-command", 3)
 	if err != nil {
 		t.Fatalf("Plan error: %v", err)
 	}
@@ -233,7 +233,7 @@ func TestPlan_DottedRef(t *testing.T) {
 		"000-root/001-child/002-leaf/SPEC.md": "---\nnumber: 2\n---\n\n# Leaf\n",
 	})
 
-	result, err := Plan(dir, "0.1.2", "leaf-renamed")
+	result, err := Plan(dir, "0.1.2", "leaf-renamed", 3)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -265,3 +265,17 @@ func TestStripNumericPrefix(t *testing.T) {
 		})
 	}
 }
+
+func TestPlan_Padding(t *testing.T) {
+	dir := setupSpecsDir(t, map[string]string{
+		"003-old-name/SPEC.md": "---\nnumber: 3\n---\n\n# Status Command\n",
+	})
+
+	result, err := Plan(dir, "3", "status-command", 4)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := filepath.Base(filepath.Dir(result.NewPath)); got != "0003-status-command" {
+		t.Errorf("expected new spec directory to be 0003-status-command, got %s", got)
+	}
+}
@@ -0,0 +1,92 @@
+// Package rules names the spec validation rules and the severities a
+// project can give them in .specture.yaml.
+package rules
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Rule names. Each validation finding records the rule that produced it so
+// projects can change a rule's severity.
+const (
+	Read             = "read"
+	SpecPath         = "spec-path"
+	Frontmatter      = "frontmatter"
+	RequiredFields   = "required-fields"
+	Status           = "status"
+	Title            = "title"
+	NumberedHeadings = "numbered-headings"
+	DuplicateRef     = "duplicate-ref"
+	RefFields        = "ref-fields"
+	UnknownRef       = "unknown-ref"
+	DependencyCycle  = "dependency-cycle"
+	Supersession     = "supersession"
+	StatusTransition = "status-transition"
+	Assignee         = "assignee"
+	Labels           = "labels"
+	Priority         = "priority"
+)
+
+// All lists every rule name.
+var All = []string{
+	Read,
+	SpecPath,
+	Frontmatter,
+	RequiredFields,
+	Status,
+	Title,
+	NumberedHeadings,
+	DuplicateRef,
+	RefFields,
+	UnknownRef,
+	DependencyCycle,
+	Supersession,
+	StatusTransition,
+	Assignee,
+	Labels,
+	Priority,
+}
+
+// Descriptions summarizes what each rule checks, for reports that describe
+// their rules.
+var Descriptions = map[string]string{
+	Read:             "Spec files can be read",
+	SpecPath:         "Spec paths encode a numbered ref",
+	Frontmatter:      "Specs start with YAML frontmatter",
+	RequiredFields:   "Frontmatter sets every required field",
+	Status:           "The status is one of the allowed statuses",
+	Title:            "Specs have an H1 title",
+	NumberedHeadings: "Section headings are not numbered",
+	DuplicateRef:     "No two specs share a ref",
+	RefFields:        "Ref list fields hold valid refs",
+	UnknownRef:       "Ref list fields name existing specs",
+	DependencyCycle:  "Dependencies do not form a cycle",
+	Supersession:     "Supersession is recorded on both specs",
+	StatusTransition: "Status changes follow the workflow",
+	Assignee:         "The assignee is a name or a list of names",
+	Labels:           "Labels are well-formed and allowed",
+	Priority:         "The priority is one of the configured priorities",
+}
+
+// Severity controls how a rule's findings are reported.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+	SeverityOff     Severity = "off"
+)
+
+// Check returns an error when name is not a rule or severity is not one of
+// error, warning, or off.
+func Check(name string, severity Severity) error {
+	if !slices.Contains(All, name) {
+		return fmt.Errorf("unknown validation rule %q", name)
+	}
+	switch severity {
+	case SeverityError, SeverityWarning, SeverityOff:
+		return nil
+	}
+	return fmt.Errorf("invalid severity %q for rule %s (must be error, warning, or off)", severity, name)
+}
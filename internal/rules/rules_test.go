@@ -0,0 +1,23 @@
+package rules
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	for _, name := range All {
+		if Descriptions[name] == "" {
+			t.Errorf("rule %s has no description", name)
+		}
+		if err := Check(name, SeverityWarning); err != nil {
+			t.Errorf("Check(%s) error = %v", name, err)
+		}
+	}
+	if err := Check("numbred-headings", SeverityOff); err == nil || !strings.Contains(err.Error(), `unknown validation rule "numbred-headings"`) {
+		t.Errorf("expected unknown rule error, got %v", err)
+	}
+	if err := Check(Title, "fatal"); err == nil || !strings.Contains(err.Error(), `invalid severity "fatal"`) {
+		t.Errorf("expected invalid severity error, got %v", err)
+	}
+}
@@ -0,0 +1,278 @@
+// Package search provides ranked full-text search across spec documents.
+package search
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	specpkg "github.com/specture-system/specture/internal/spec"
+	"github.com/yuin/goldmark/ast"
+)
+
+// Score weights for a single occurrence of a query term.
+const (
+	titleWeight   = 10
+	headingWeight = 5
+	bodyWeight    = 1
+)
+
+// snippetWidth is the approximate number of characters kept around the first
+// match in a snippet.
+const snippetWidth = 80
+
+// documentNames lists the files searched in each spec directory.
+var documentNames = []string{"SPEC.md", "PLAN.md"}
+
+// Result is a spec that matches a query.
+type Result struct {
+	Node  *specpkg.Node
+	Score int
+	// File is the document the snippet was taken from, or empty when only the
+	// title matched.
+	File string
+	// Snippet is the best matching heading or body line, shortened around the
+	// first match.
+	Snippet string
+	// Highlights holds the byte ranges of query terms within Snippet.
+	Highlights []Range
+}
+
+// Range is a half-open byte range.
+type Range struct {
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// ParseQuery splits a query into lowercase search terms.
+func ParseQuery(query string) []string {
+	var terms []string
+	for _, field := range strings.Fields(strings.ToLower(query)) {
+		if !slices.Contains(terms, field) {
+			terms = append(terms, field)
+		}
+	}
+	return terms
+}
+
+// Search returns the nodes whose title, headings, or body text contain every
+// term of query, ranked by score. Title and heading matches weigh more than
+// body matches. Ties keep the order of nodes.
+func Search(nodes []*specpkg.Node, query string) ([]Result, error) {
+	terms := ParseQuery(query)
+	if len(terms) == 0 {
+		return nil, fmt.Errorf("search query is empty")
+	}
+
+	var results []Result
+	for _, node := range nodes {
+		result, ok, err := searchNode(node, terms)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			results = append(results, result)
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+	return results, nil
+}
+
+// line is a searchable line of a spec document.
+type line struct {
+	file   string
+	text   string
+	weight int
+}
+
+// candidate is the line chosen for a result's snippet.
+type candidate struct {
+	line  line
+	terms int
+}
+
+func searchNode(node *specpkg.Node, terms []string) (Result, bool, error) {
+	lines := []line{{text: node.Spec.Name, weight: titleWeight}}
+	dir := filepath.Dir(node.FilePath)
+	for _, name := range documentNames {
+		content, err := os.ReadFile(filepath.Join(dir, name))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return Result{}, false, fmt.Errorf("failed to read %s: %w", name, err)
+		}
+		// The spec title comes from the H1 of the spec file itself, which is
+		// SPEC.md or, for plan-only specs, PLAN.md.
+		lines = append(lines, documentLines(name, string(content), name == filepath.Base(node.FilePath))...)
+	}
+
+	score := 0
+	found := make(map[string]bool, len(terms))
+	var best candidate
+	for _, l := range lines {
+		lower := strings.ToLower(l.text)
+		matched := 0
+		for _, term := range terms {
+			if count := strings.Count(lower, term); count > 0 {
+				score += count * l.weight
+				found[term] = true
+				matched++
+			}
+		}
+		// The title is already shown with every result, so the snippet
+		// comes from the document itself.
+		if l.file != "" && matched > best.terms {
+			best = candidate{line: l, terms: matched}
+		}
+	}
+	if len(found) < len(terms) {
+		return Result{}, false, nil
+	}
+
+	result := Result{Node: node, Score: score}
+	if best.terms > 0 {
+		result.File = best.line.file
+		result.Snippet, result.Highlights = snippet(best.line.text, terms)
+	}
+	return result, true, nil
+}
+
+// documentLines returns the headings, paragraphs, and code lines of a
+// markdown document, as parsed for spec metadata. Frontmatter, link
+// destinations, and markdown syntax are not searched. When hasTitle is set,
+// the first H1 is skipped, since the spec title is searched through its
+// parsed name.
+func documentLines(file, content string, hasTitle bool) []line {
+	source := []byte(content)
+	doc, _ := specpkg.ParseMarkdown(source)
+
+	var lines []line
+	add := func(text string, weight int) {
+		if text = strings.TrimSpace(text); text != "" {
+			lines = append(lines, line{file: file, text: text, weight: weight})
+		}
+	}
+	ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch n := n.(type) {
+		case *ast.Heading:
+			if n.Level == 1 && hasTitle {
+				hasTitle = false
+				return ast.WalkSkipChildren, nil
+			}
+			add(specpkg.InlineText(n, source), headingWeight)
+			return ast.WalkSkipChildren, nil
+		case *ast.Paragraph, *ast.TextBlock:
+			add(specpkg.InlineText(n, source), bodyWeight)
+			return ast.WalkSkipChildren, nil
+		case *ast.FencedCodeBlock, *ast.CodeBlock:
+			// Code counts as body text, one line at a time.
+			segments := n.Lines()
+			for i := 0; i < segments.Len(); i++ {
+				segment := segments.At(i)
+				add(string(segment.Value(source)), bodyWeight)
+			}
+			return ast.WalkSkipChildren, nil
+		}
+		return ast.WalkContinue, nil
+	})
+	return lines
+}
+
+// snippet shortens text to about snippetWidth characters around the first
+// term match and returns the byte ranges of every term match in the result.
+func snippet(text string, terms []string) (string, []Range) {
+	lower := strings.ToLower(text)
+	first := len(text)
+	for _, term := range terms {
+		if i := strings.Index(lower, term); i >= 0 && i < first {
+			first = i
+		}
+	}
+
+	if len(text) > snippetWidth {
+		start := max(0, first-snippetWidth/4)
+		end := min(len(text), start+snippetWidth)
+		start = max(0, end-snippetWidth)
+		start = wordBoundary(text, start, -1)
+		end = wordBoundary(text, end, 1)
+
+		shortened := strings.TrimSpace(text[start:end])
+		if start > 0 {
+			shortened = "…" + shortened
+		}
+		if end < len(text) {
+			shortened += "…"
+		}
+		text = shortened
+		lower = strings.ToLower(text)
+	}
+
+	// Lowercasing can change the byte length of some characters, in which
+	// case offsets in lower do not apply to text.
+	if len(lower) != len(text) {
+		return text, nil
+	}
+
+	var ranges []Range
+	for _, term := range terms {
+		for offset := 0; ; {
+			i := strings.Index(lower[offset:], term)
+			if i < 0 {
+				break
+			}
+			ranges = append(ranges, Range{Start: offset + i, End: offset + i + len(term)})
+			offset += i + len(term)
+		}
+	}
+	return text, mergeRanges(ranges)
+}
+
+// wordBoundary moves i in direction step until it sits on a space or either
+// end of text.
+func wordBoundary(text string, i, step int) int {
+	for i > 0 && i < len(text) && text[i] != ' ' {
+		i += step
+	}
+	return i
+}
+
+// mergeRanges sorts ranges and merges overlapping ones.
+func mergeRanges(ranges []Range) []Range {
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].Start < ranges[j].Start
+	})
+	var merged []Range
+	for _, r := range ranges {
+		if n := len(merged); n > 0 && r.Start <= merged[n-1].End {
+			merged[n-1].End = max(merged[n-1].End, r.End)
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// Highlight wraps each range of text in open and close markers.
+func Highlight(text string, ranges []Range, open, close string) string {
+	var b strings.Builder
+	last := 0
+	for _, r := range ranges {
+		b.WriteString(text[last:r.Start])
+		b.WriteString(open)
+		b.WriteString(text[r.Start:r.End])
+		b.WriteString(close)
+		last = r.End
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
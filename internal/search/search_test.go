@@ -0,0 +1,157 @@
+package search
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	specpkg "github.com/specture-system/specture/internal/spec"
+)
+
+func buildSearchTree(t *testing.T, files map[string]string) *specpkg.Tree {
+	t.Helper()
+	specsDir := filepath.Join(t.TempDir(), "specs")
+	for name, content := range files {
+		path := filepath.Join(specsDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("failed to create parent dir for %s: %v", name, err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+	tree, err := specpkg.BuildTree(specsDir)
+	if err != nil {
+		t.Fatalf("failed to build tree: %v", err)
+	}
+	return tree
+}
+
+func resultRefs(results []Result) []string {
+	refs := make([]string, 0, len(results))
+	for _, result := range results {
+		refs = append(refs, result.Node.Spec.FullRef)
+	}
+	return refs
+}
+
+func TestSearch_RanksTitleAndHeadingsAboveBody(t *testing.T) {
+	tree := buildSearchTree(t, map[string]string{
+		"001-body/SPEC.md":    "---\nstatus: draft\n---\n\n# Body Spec\n\nThe cache is mentioned once.\n",
+		"002-heading/SPEC.md": "---\nstatus: draft\n---\n\n# Heading Spec\n\n## Cache Layout\n\nDetails.\n",
+		"003-title/SPEC.md":   "---\nstatus: draft\n---\n\n# Cache Spec\n\nNothing else.\n",
+		"004-none/SPEC.md":    "---\nstatus: draft\n---\n\n# Unrelated\n\nNothing here.\n",
+	})
+
+	results, err := Search(tree.Nodes(), "CACHE")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := strings.Join(resultRefs(results), ","); got != "3,2,1" {
+		t.Errorf("expected results ranked 3,2,1, got %s", got)
+	}
+}
+
+func TestSearch_RequiresEveryTerm(t *testing.T) {
+	tree := buildSearchTree(t, map[string]string{
+		"001-both/SPEC.md": "---\nstatus: draft\n---\n\n# Both\n\nFrontmatter keys control numbering.\n",
+		"002-one/SPEC.md":  "---\nstatus: draft\n---\n\n# One\n\nFrontmatter only.\n",
+		"003-plan/SPEC.md": "---\nstatus: draft\n---\n\n# Planned\n\nFrontmatter here.\n",
+		"003-plan/PLAN.md": "---\nstatus: draft\n---\n\n# Plan\n\n- [ ] Fix numbering\n",
+	})
+
+	results, err := Search(tree.Nodes(), "frontmatter numbering")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := strings.Join(resultRefs(results), ","); got != "1,3" {
+		t.Errorf("expected specs 1 and 3, got %s", got)
+	}
+	if results[0].File != "SPEC.md" || results[0].Snippet != "Frontmatter keys control numbering." {
+		t.Errorf("unexpected snippet %s: %q", results[0].File, results[0].Snippet)
+	}
+	want := []Range{{Start: 0, End: 11}, {Start: 25, End: 34}}
+	if len(results[0].Highlights) != 2 || results[0].Highlights[0] != want[0] || results[0].Highlights[1] != want[1] {
+		t.Errorf("expected highlights %v, got %v", want, results[0].Highlights)
+	}
+}
+
+func TestSearch_PlanOnlyTitleScoredOnce(t *testing.T) {
+	tree := buildSearchTree(t, map[string]string{
+		"001-spec/SPEC.md": "---\nstatus: draft\n---\n\n# Cache Spec\n\nNothing else.\n",
+		"002-plan/PLAN.md": "---\nstatus: draft\n---\n\n# Cache Plan\n\nNothing else.\n",
+		"003-both/SPEC.md": "---\nstatus: draft\n---\n\n# Both\n",
+		"003-both/PLAN.md": "# Cache Plan\n",
+	})
+
+	results, err := Search(tree.Nodes(), "cache")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	scores := make(map[string]int)
+	for _, result := range results {
+		scores[result.Node.Spec.FullRef] = result.Score
+	}
+	if scores["1"] != titleWeight || scores["2"] != titleWeight {
+		t.Errorf("expected SPEC.md and PLAN.md titles to score %d once, got %v", titleWeight, scores)
+	}
+	// A PLAN.md beside a SPEC.md does not supply the title, so its H1 is a
+	// heading.
+	if scores["3"] != headingWeight {
+		t.Errorf("expected plan H1 beside a SPEC.md to score as a heading, got %v", scores)
+	}
+}
+
+func TestSearch_EmptyQuery(t *testing.T) {
+	if _, err := Search(nil, "   "); err == nil {
+		t.Fatal("expected error for empty query")
+	}
+}
+
+func TestDocumentLines_SkipsFrontmatterAndFences(t *testing.T) {
+	lines := documentLines("SPEC.md", "---\nstatus: cache\n---\n\n# Title\n\n## Section\n\n```sh\n# cache comment\n```\n", true)
+	var texts []string
+	for _, l := range lines {
+		texts = append(texts, l.text)
+	}
+	if got := strings.Join(texts, "|"); got != "Section|# cache comment" {
+		t.Errorf("unexpected lines: %s", got)
+	}
+	if lines[0].weight != headingWeight || lines[1].weight != bodyWeight {
+		t.Errorf("unexpected weights: %+v", lines)
+	}
+}
+
+func TestDocumentLines_FollowsMarkdownStructure(t *testing.T) {
+	content := "Overview\n========\n\nSee [the list spec](/specs/002-list/SPEC.md) and <https://example.com/widgets>.\n#tag in a paragraph\n\n    # indented code about widgets\n\n- Item **one**\n"
+	lines := documentLines("SPEC.md", content, false)
+	var got []string
+	for _, l := range lines {
+		got = append(got, fmt.Sprintf("%d %s", l.weight, l.text))
+	}
+	want := []string{
+		fmt.Sprintf("%d Overview", headingWeight),
+		fmt.Sprintf("%d See the list spec and . #tag in a paragraph", bodyWeight),
+		fmt.Sprintf("%d # indented code about widgets", bodyWeight),
+		fmt.Sprintf("%d Item one", bodyWeight),
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("unexpected lines:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestSnippet_ShortensLongLines(t *testing.T) {
+	text := strings.Repeat("lead ", 30) + "needle " + strings.Repeat("tail ", 30)
+	got, ranges := snippet(text, []string{"needle"})
+	if !strings.HasPrefix(got, "…") || !strings.HasSuffix(got, "…") {
+		t.Errorf("expected ellipses on both ends, got %q", got)
+	}
+	if len(ranges) != 1 || got[ranges[0].Start:ranges[0].End] != "needle" {
+		t.Errorf("expected highlight on needle, got %v in %q", ranges, got)
+	}
+	if highlighted := Highlight("a needle b", []Range{{Start: 2, End: 8}}, "**", "**"); highlighted != "a **needle** b" {
+		t.Errorf("unexpected highlight %q", highlighted)
+	}
+}
@@ -0,0 +1,229 @@
+package spec
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+)
+
+const (
+	// CacheDir is the cache location relative to the project root (the parent
+	// of the specs directory).
+	CacheDir = ".specture/cache"
+
+	cacheIndexFilename = "index.json"
+
+	// cacheVersion must be bumped whenever the cached SpecInfo shape or the
+	// parsing rules change, so stale indexes are discarded instead of reused.
+	cacheVersion = 11
+)
+
+var cacheDisabled atomic.Bool
+
+// SetCacheEnabled turns the on-disk parse cache on or off for this process.
+// The cache is enabled by default.
+func SetCacheEnabled(enabled bool) {
+	cacheDisabled.Store(!enabled)
+}
+
+// cacheIndex is the on-disk index of parsed specs for one specs directory.
+type cacheIndex struct {
+	Version int                    `json:"version"`
+	Entries map[string]*cacheEntry `json:"entries"`
+
+	path  string
+	dirty bool
+}
+
+// cacheEntry records a parsed spec along with the file attributes it was
+// parsed from. An entry is reused only while size and mtime still match,
+// both for the spec file and for the PLAN.md beside it, whose task progress
+// is part of the parsed spec.
+type cacheEntry struct {
+	Size        int64     `json:"size"`
+	ModTime     int64     `json:"mod_time"`
+	PlanSize    int64     `json:"plan_size"`
+	PlanModTime int64     `json:"plan_mod_time"`
+	Info        *SpecInfo `json:"info"`
+}
+
+// parseSpecs parses the spec files at paths, reusing cached results for files
+// that have not changed since they were last parsed. Paths are returned as
+// given; callers are responsible for making them relative.
+func parseSpecs(specsDir string, paths []string) ([]*SpecInfo, error) {
+	index := loadCacheIndex(specsDir)
+
+	specs := make([]*SpecInfo, 0, len(paths))
+	for _, path := range paths {
+		info, err := parseCached(index, specsDir, path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+		specs = append(specs, info)
+	}
+
+	if index != nil {
+		index.prune(specsDir)
+		// The cache is best-effort; failing to persist it must not fail the
+		// command that triggered the parse.
+		_ = index.save()
+	}
+
+	return specs, nil
+}
+
+// parseCached returns the parsed spec at path, consulting and refreshing the
+// index when one is available.
+func parseCached(index *cacheIndex, specsDir, path string) (*SpecInfo, error) {
+	if index == nil {
+		return Parse(path)
+	}
+
+	stat, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	planSize, planModTime := planFileStat(path)
+	key := cacheKey(specsDir, path)
+	if entry, ok := index.Entries[key]; ok && entry.Info != nil &&
+		entry.Size == stat.Size() && entry.ModTime == stat.ModTime().UnixNano() &&
+		entry.PlanSize == planSize && entry.PlanModTime == planModTime {
+		info := *entry.Info
+		// Path-derived fields depend on neighbouring files, not on this
+		// file's content, so refresh them instead of trusting the cache.
+		info.Path = path
+		info.FullRef, err = resolveFullRef(path, info.Number)
+		if err != nil {
+			return nil, err
+		}
+		info.HasPlan = hasPlanFile(path)
+		if info.Extra != nil {
+			info.Extra = cachedExtraValue(info.Extra).(map[string]any)
+		}
+		return &info, nil
+	}
+
+	info, err := Parse(path)
+	if err != nil {
+		return nil, err
+	}
+	cached := *info
+	index.Entries[key] = &cacheEntry{
+		Size:        stat.Size(),
+		ModTime:     stat.ModTime().UnixNano(),
+		PlanSize:    planSize,
+		PlanModTime: planModTime,
+		Info:        &cached,
+	}
+	index.dirty = true
+	return info, nil
+}
+
+// planFileStat returns the size and mtime of the PLAN.md beside the spec
+// file at path, or -1 for both when there is none.
+func planFileStat(path string) (int64, int64) {
+	stat, err := os.Stat(filepath.Join(filepath.Dir(path), planFilename))
+	if err != nil {
+		return -1, -1
+	}
+	return stat.Size(), stat.ModTime().UnixNano()
+}
+
+// loadCacheIndex reads the index for specsDir. It returns nil when caching is
+// disabled, and an empty index when the file is missing, unreadable, or was
+// written by a different cache version.
+func loadCacheIndex(specsDir string) *cacheIndex {
+	if cacheDisabled.Load() {
+		return nil
+	}
+
+	path := filepath.Join(filepath.Dir(specsDir), CacheDir, cacheIndexFilename)
+	index := &cacheIndex{path: path}
+
+	data, err := os.ReadFile(path)
+	if err == nil {
+		// Numbers in Extra are decoded as json.Number so whole numbers come
+		// back as int, as parsing produces, rather than float64.
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		if err := decoder.Decode(index); err != nil || index.Version != cacheVersion {
+			index.Entries = nil
+		}
+	}
+	if index.Entries == nil {
+		index.Entries = make(map[string]*cacheEntry)
+		index.dirty = true
+	}
+	index.Version = cacheVersion
+	return index
+}
+
+// prune drops entries for spec files that no longer exist.
+func (c *cacheIndex) prune(specsDir string) {
+	for key := range c.Entries {
+		if _, err := os.Stat(filepath.Join(specsDir, filepath.FromSlash(key))); os.IsNotExist(err) {
+			delete(c.Entries, key)
+			c.dirty = true
+		}
+	}
+}
+
+// save writes the index atomically when it has changed.
+func (c *cacheIndex) save() error {
+	if !c.dirty {
+		return nil
+	}
+
+	dir := filepath.Dir(c.path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	// Keep the cache out of version control without requiring projects to
+	// edit their own .gitignore.
+	ignorePath := filepath.Join(dir, ".gitignore")
+	if _, err := os.Stat(ignorePath); os.IsNotExist(err) {
+		if err := os.WriteFile(ignorePath, []byte("*\n"), 0644); err != nil {
+			return fmt.Errorf("failed to write cache .gitignore: %w", err)
+		}
+	}
+
+	data, err := json.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("failed to encode cache index: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, cacheIndexFilename+".*")
+	if err != nil {
+		return fmt.Errorf("failed to write cache index: %w", err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write cache index: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write cache index: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), c.path); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write cache index: %w", err)
+	}
+
+	c.dirty = false
+	return nil
+}
+
+// cacheKey identifies a spec file by its slash-separated path relative to the
+// specs directory, so the index survives moving the repository.
+func cacheKey(specsDir, path string) string {
+	rel, err := filepath.Rel(specsDir, path)
+	if err != nil {
+		return filepath.ToSlash(path)
+	}
+	return filepath.ToSlash(rel)
+}
@@ -0,0 +1,212 @@
+package spec
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// setupCacheTest creates a project with a specs directory and returns the
+// specs directory and the path of one spec inside it.
+func setupCacheTest(t *testing.T) (string, string) {
+	t.Helper()
+	specsDir := filepath.Join(t.TempDir(), "specs")
+	specPath := filepath.Join(specsDir, "001-cached", specFilename)
+	if err := os.MkdirAll(filepath.Dir(specPath), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(specPath, buildSpec("status: draft", "Cached", ""), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return specsDir, specPath
+}
+
+func cacheIndexPath(specsDir string) string {
+	return filepath.Join(filepath.Dir(specsDir), CacheDir, cacheIndexFilename)
+}
+
+func TestParseAll_WritesCacheIndex(t *testing.T) {
+	specsDir, _ := setupCacheTest(t)
+
+	if _, err := ParseAll(specsDir); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	index := loadCacheIndex(specsDir)
+	entry, ok := index.Entries["001-cached/SPEC.md"]
+	if !ok {
+		t.Fatalf("expected cache entry for spec, got %v", index.Entries)
+	}
+	if entry.Info.Name != "Cached" {
+		t.Errorf("expected cached name %q, got %q", "Cached", entry.Info.Name)
+	}
+	if _, err := os.Stat(filepath.Join(filepath.Dir(cacheIndexPath(specsDir)), ".gitignore")); err != nil {
+		t.Errorf("expected cache directory to ignore itself: %v", err)
+	}
+}
+
+func TestParseAll_ReusesCacheForUnchangedFiles(t *testing.T) {
+	specsDir, specPath := setupCacheTest(t)
+
+	if _, err := ParseAll(specsDir); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// Poison the cached entry; an unchanged file must be served from it.
+	index := loadCacheIndex(specsDir)
+	index.Entries["001-cached/SPEC.md"].Info.Name = "From Cache"
+	index.dirty = true
+	if err := index.save(); err != nil {
+		t.Fatalf("failed to save cache: %v", err)
+	}
+
+	specs, err := ParseAll(specsDir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if specs[0].Name != "From Cache" {
+		t.Errorf("expected cached name, got %q", specs[0].Name)
+	}
+	if specs[0].Path != filepath.Join("specs", "001-cached", specFilename) {
+		t.Errorf("expected relative path from cached entry, got %q", specs[0].Path)
+	}
+
+	// Changing the file invalidates the entry.
+	if err := os.WriteFile(specPath, buildSpec("status: approved", "Updated Title", ""), 0644); err != nil {
+		t.Fatal(err)
+	}
+	future := time.Now().Add(time.Hour)
+	if err := os.Chtimes(specPath, future, future); err != nil {
+		t.Fatal(err)
+	}
+
+	specs, err = ParseAll(specsDir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if specs[0].Name != "Updated Title" || specs[0].Status != "approved" {
+		t.Errorf("expected reparsed spec, got name %q status %q", specs[0].Name, specs[0].Status)
+	}
+}
+
+func TestParseAll_CacheRefreshesPathDerivedFields(t *testing.T) {
+	specsDir, specPath := setupCacheTest(t)
+
+	specs, err := ParseAll(specsDir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if specs[0].HasPlan {
+		t.Fatal("expected no plan before one is added")
+	}
+
+	if err := os.WriteFile(filepath.Join(filepath.Dir(specPath), planFilename), []byte("# Plan\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	specs, err = ParseAll(specsDir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !specs[0].HasPlan {
+		t.Error("expected HasPlan to be refreshed for a cached spec")
+	}
+}
+
+func TestParseAll_PrunesDeletedSpecs(t *testing.T) {
+	specsDir, specPath := setupCacheTest(t)
+	otherPath := filepath.Join(specsDir, "002-other", specFilename)
+	if err := os.MkdirAll(filepath.Dir(otherPath), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(otherPath, buildSpec("status: draft", "Other", ""), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := ParseAll(specsDir); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := os.RemoveAll(filepath.Dir(otherPath)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ParseAll(specsDir); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	index := loadCacheIndex(specsDir)
+	if _, ok := index.Entries["002-other/SPEC.md"]; ok {
+		t.Error("expected deleted spec to be pruned from the cache")
+	}
+	if _, ok := index.Entries[cacheKey(specsDir, specPath)]; !ok {
+		t.Error("expected remaining spec to stay cached")
+	}
+}
+
+func TestParseAll_DiscardsCacheFromOtherVersion(t *testing.T) {
+	specsDir, _ := setupCacheTest(t)
+	indexPath := cacheIndexPath(specsDir)
+	if err := os.MkdirAll(filepath.Dir(indexPath), 0755); err != nil {
+		t.Fatal(err)
+	}
+	stale := `{"version": 0, "entries": {"001-cached/SPEC.md": {"size": 0, "mod_time": 0, "info": {"Name": "Stale"}}}}`
+	if err := os.WriteFile(indexPath, []byte(stale), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	specs, err := ParseAll(specsDir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if specs[0].Name != "Cached" {
+		t.Errorf("expected stale cache to be ignored, got name %q", specs[0].Name)
+	}
+	if index := loadCacheIndex(specsDir); index.Entries["001-cached/SPEC.md"].Info.Name != "Cached" {
+		t.Error("expected index to be rewritten with the current version")
+	}
+}
+
+func TestParseAll_CacheDisabled(t *testing.T) {
+	SetCacheEnabled(false)
+	t.Cleanup(func() { SetCacheEnabled(true) })
+
+	specsDir, _ := setupCacheTest(t)
+	if _, err := ParseAll(specsDir); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := os.Stat(cacheIndexPath(specsDir)); !os.IsNotExist(err) {
+		t.Errorf("expected no cache index when caching is disabled, got %v", err)
+	}
+}
+
+func TestParseAll_CachedExtraMatchesParsed(t *testing.T) {
+	specsDir, specPath := setupCacheTest(t)
+	frontmatter := "status: draft\ntarget: 2026-03-01\npoints: 5\nratio: 1.5\nbig: 9007199254740993\nflag: true\nnested:\n  due: 2026-04-01\n  tags: [a, 2]"
+	if err := os.WriteFile(specPath, buildSpec(frontmatter, "Cached", ""), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	parsed, err := ParseAll(specsDir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	cached, err := ParseAll(specsDir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]any{
+		"target": "2026-03-01",
+		"points": 5,
+		"ratio":  1.5,
+		"big":    9007199254740993,
+		"flag":   true,
+		"nested": map[string]any{"due": "2026-04-01", "tags": []any{"a", 2}},
+	}
+	for name, extra := range map[string]map[string]any{"parsed": parsed[0].Extra, "cached": cached[0].Extra} {
+		if !reflect.DeepEqual(extra, want) {
+			t.Errorf("%s extra = %#v, want %#v", name, extra, want)
+		}
+	}
+}
@@ -0,0 +1,251 @@
+package spec
+
+import (
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/specture-system/specture/internal/git"
+	gmfrontmatter "go.abhg.dev/goldmark/frontmatter"
+)
+
+// Kinds of Change.
+const (
+	ChangeAdded    = "added"
+	ChangeRemoved  = "removed"
+	ChangeRenamed  = "renamed"
+	ChangeModified = "modified"
+)
+
+// Change describes how one spec differs between two git revisions.
+type Change struct {
+	// Kind is ChangeAdded, ChangeRemoved, ChangeRenamed, or ChangeModified.
+	// A renamed spec may also have modified content.
+	Kind string
+	// Ref, Name, Status, and Path describe the spec at the new revision, and
+	// the Old fields at the old revision. The side where the spec does not
+	// exist is left empty. Paths are relative to the project root, like
+	// SpecInfo.Path.
+	Ref       string
+	Name      string
+	Status    string
+	Path      string
+	OldRef    string
+	OldName   string
+	OldStatus string
+	OldPath   string
+	// Files lists the changed files of the spec, such as SPEC.md and
+	// PLAN.md, sorted.
+	Files []string
+}
+
+// Changes lists the specs under specsDir that changed between the git
+// revisions base and head, ordered by ref. Like a pull request, head is
+// compared against its merge base with base, so changes made on base after
+// head branched off are not reported. SPEC.md and PLAN.md changes count; other
+// files in spec directories do not. A spec directory that keeps its number
+// and parent but changes slug is reported as renamed, however much its
+// content changed.
+func Changes(specsDir, base, head string) ([]Change, error) {
+	absSpecsDir, err := filepath.Abs(specsDir)
+	if err != nil {
+		return nil, err
+	}
+	for _, rev := range []string{base, head} {
+		if err := git.VerifyRevision(absSpecsDir, rev); err != nil {
+			return nil, err
+		}
+	}
+	mergeBase, err := git.MergeBase(absSpecsDir, base, head)
+	if err != nil {
+		return nil, err
+	}
+	files, err := git.DiffFiles(absSpecsDir, mergeBase, head)
+	if err != nil {
+		return nil, err
+	}
+
+	// Pair each spec directory at the merge base with its directory at
+	// head. Renamed spec files move their directory, and other files in a
+	// renamed directory follow it even when git did not pair them.
+	movedTo := make(map[string]string)
+	movedFrom := make(map[string]string)
+	var removedDirs, addedDirs []string
+	for _, file := range files {
+		switch {
+		case file.Status == "R" && IsSpecFilePath(file.Path) && IsSpecFilePath(file.OldPath):
+			oldDir, newDir := path.Dir(file.OldPath), path.Dir(file.Path)
+			movedTo[oldDir], movedFrom[newDir] = newDir, oldDir
+		case file.Status == "D" && IsSpecFilePath(file.Path):
+			removedDirs = append(removedDirs, path.Dir(file.Path))
+		case file.Status == "A" && IsSpecFilePath(file.Path):
+			addedDirs = append(addedDirs, path.Dir(file.Path))
+		}
+	}
+	pairRenamedDirs(removedDirs, addedDirs, movedTo, movedFrom)
+
+	type dirPair struct{ old, new string }
+	changed := make(map[dirPair][]string)
+	var pairs []dirPair
+	for _, file := range files {
+		if !IsSpecFilePath(file.Path) && !IsSpecFilePath(file.OldPath) {
+			continue
+		}
+		pair := dirPair{old: path.Dir(file.OldPath), new: path.Dir(file.Path)}
+		if file.Status != "R" {
+			if from, ok := movedFrom[pair.new]; ok {
+				pair.old = from
+			} else if to, ok := movedTo[pair.old]; ok {
+				pair.new = to
+			}
+		}
+		if _, ok := changed[pair]; !ok {
+			pairs = append(pairs, pair)
+		}
+		name := path.Base(file.Path)
+		if !slices.Contains(changed[pair], name) {
+			changed[pair] = append(changed[pair], name)
+		}
+	}
+
+	// Read the spec files of the changed directories on each side at once.
+	oldDirs := make(map[string]bool, len(pairs))
+	newDirs := make(map[string]bool, len(pairs))
+	for _, pair := range pairs {
+		oldDirs[pair.old], newDirs[pair.new] = true, true
+	}
+	oldFiles, err := git.ReadFiles(absSpecsDir, mergeBase, func(p string) bool {
+		return IsSpecFilePath(p) && oldDirs[path.Dir(p)]
+	})
+	if err != nil {
+		return nil, err
+	}
+	newFiles, err := git.ReadFiles(absSpecsDir, head, func(p string) bool {
+		return IsSpecFilePath(p) && newDirs[path.Dir(p)]
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []Change
+	for _, pair := range pairs {
+		oldInfo := specInFiles(absSpecsDir, oldFiles, pair.old)
+		newInfo := specInFiles(absSpecsDir, newFiles, pair.new)
+
+		change := Change{Files: changed[pair]}
+		slices.Sort(change.Files)
+		switch {
+		case oldInfo == nil && newInfo == nil:
+			continue
+		case oldInfo == nil:
+			change.Kind = ChangeAdded
+		case newInfo == nil:
+			change.Kind = ChangeRemoved
+		case pair.old != pair.new:
+			change.Kind = ChangeRenamed
+		default:
+			change.Kind = ChangeModified
+		}
+		if newInfo != nil {
+			change.Ref, change.Name, change.Status, change.Path = newInfo.FullRef, newInfo.Name, newInfo.Status, newInfo.Path
+		}
+		if oldInfo != nil {
+			change.OldRef, change.OldName, change.OldStatus, change.OldPath = oldInfo.FullRef, oldInfo.Name, oldInfo.Status, oldInfo.Path
+		}
+		changes = append(changes, change)
+	}
+
+	slices.SortStableFunc(changes, func(a, b Change) int {
+		return compareRefs(firstNonEmpty(a.Ref, a.OldRef), firstNonEmpty(b.Ref, b.OldRef))
+	})
+	return changes, nil
+}
+
+// pairRenamedDirs adds to movedTo and movedFrom the spec directories that
+// lost their spec files and the ones that gained them when they keep their
+// number under the same parent but change slug, as specture rename does.
+// git pairs such files only when their content is similar enough, which a
+// short spec edited along with the rename may not be. Parents are paired
+// before their children, so a child of a renamed parent is matched under
+// the parent's new directory.
+func pairRenamedDirs(removedDirs, addedDirs []string, movedTo, movedFrom map[string]string) {
+	depth := func(dir string) int { return strings.Count(dir, "/") }
+	slices.SortStableFunc(removedDirs, func(a, b string) int { return depth(a) - depth(b) })
+	for _, oldDir := range removedDirs {
+		if _, ok := movedTo[oldDir]; ok {
+			continue
+		}
+		number := extractNumberFromSpecPath(path.Base(oldDir))
+		if number < 0 {
+			continue
+		}
+		parent := path.Dir(oldDir)
+		if to, ok := movedTo[parent]; ok {
+			parent = to
+		}
+		for _, newDir := range addedDirs {
+			if _, ok := movedFrom[newDir]; ok || newDir == oldDir || path.Dir(newDir) != parent {
+				continue
+			}
+			if extractNumberFromSpecPath(path.Base(newDir)) == number {
+				movedTo[oldDir], movedFrom[newDir] = newDir, oldDir
+				break
+			}
+		}
+	}
+}
+
+// firstNonEmpty returns the first non-empty value.
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// specInFiles reads the spec in dir, relative to specsDir, from files, the
+// spec files at a revision as returned by git.ReadFiles. Like FindAll, it
+// prefers SPEC.md and falls back to PLAN.md. It returns nil when dir held no
+// spec.
+func specInFiles(specsDir string, files map[string][]byte, dir string) *SpecInfo {
+	for _, filename := range []string{specFilename, planFilename} {
+		relPath := path.Join(dir, filename)
+		content, ok := files[relPath]
+		if !ok {
+			continue
+		}
+
+		doc, ctx := ParseMarkdown(content)
+		var fm frontmatter
+		if data := gmfrontmatter.Get(ctx); data != nil {
+			_ = data.Decode(&fm)
+		}
+		return &SpecInfo{
+			Path:    relSpecPath(specsDir, filepath.Join(specsDir, filepath.FromSlash(relPath))),
+			Name:    extractTitle(doc, content),
+			FullRef: refFromDir(dir),
+			Status:  InferStatus(fm.Status),
+		}
+	}
+	return nil
+}
+
+// refFromDir derives a spec's full ref from its directory relative to the
+// specs directory, such as 1.2 for 001-parent/002-child. It mirrors
+// resolveFullRef for revisions that are not checked out.
+func refFromDir(dir string) string {
+	var parts []string
+	components := strings.Split(dir, "/")
+	for i := len(components) - 1; i >= 0; i-- {
+		number := extractNumberFromSpecPath(components[i])
+		if number < 0 {
+			break
+		}
+		parts = append([]string{strconv.Itoa(number)}, parts...)
+	}
+	return strings.Join(parts, ".")
+}
@@ -0,0 +1,88 @@
+package spec
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/specture-system/specture/internal/testhelpers"
+)
+
+func TestChanges(t *testing.T) {
+	dir := t.TempDir()
+	testhelpers.InitGitRepo(t, dir)
+	git := func(args ...string) {
+		t.Helper()
+		if err := testhelpers.RunGitCommand(dir, args); err != nil {
+			t.Fatalf("git %v failed: %v", args, err)
+		}
+	}
+	write := func(name, status, title string) {
+		testhelpers.WriteFile(t, dir, "specs/"+name, "---\nstatus: "+status+"\n---\n\n# "+title+"\n\n"+strings.Repeat("Details of "+title+".\n", 5))
+	}
+
+	write("001-first/SPEC.md", "draft", "First")
+	write("002-second/SPEC.md", "approved", "Second")
+	write("003-third/SPEC.md", "draft", "Third")
+	write("004-fourth/SPEC.md", "approved", "Fourth")
+	write("004-fourth/001-child/SPEC.md", "draft", "Child")
+	testhelpers.WriteFile(t, dir, "specs/006-short/SPEC.md", "# Short\n")
+	git("add", "-A")
+	git("commit", "-m", "base")
+	git("branch", "-M", "main")
+	git("checkout", "-q", "-b", "feature")
+
+	write("001-first/SPEC.md", "approved", "First")
+	testhelpers.WriteFile(t, dir, "specs/002-second/PLAN.md", "# Plan\n")
+	testhelpers.WriteFile(t, dir, "specs/002-second/diagram.txt", "not a spec file\n")
+	if err := os.Rename(filepath.Join(dir, "specs/003-third"), filepath.Join(dir, "specs/003-renamed")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.RemoveAll(filepath.Join(dir, "specs/004-fourth/001-child")); err != nil {
+		t.Fatal(err)
+	}
+	write("005-fifth/SPEC.md", "draft", "Fifth")
+	// Renaming a short spec and editing it leaves too little in common for
+	// git to pair the files; keeping the number under the same parent still
+	// makes it a rename.
+	if err := os.RemoveAll(filepath.Join(dir, "specs/006-short")); err != nil {
+		t.Fatal(err)
+	}
+	testhelpers.WriteFile(t, dir, "specs/006-brief/SPEC.md", "---\nstatus: approved\n---\n\n# Brief\n")
+	git("add", "-A")
+	git("commit", "-m", "feature")
+
+	// Changes on main after the branch point are not part of the branch.
+	git("checkout", "-q", "main")
+	write("004-fourth/SPEC.md", "completed", "Fourth")
+	git("commit", "-am", "main")
+
+	changes, err := Changes(filepath.Join(dir, "specs"), "main", "feature")
+	if err != nil {
+		t.Fatalf("Changes() error = %v", err)
+	}
+
+	var got []string
+	for _, c := range changes {
+		got = append(got, strings.Join([]string{c.Kind, c.OldRef, c.Ref, c.OldStatus, c.Status, c.OldPath, c.Path, strings.Join(c.Files, "+")}, " "))
+	}
+	want := []string{
+		"modified 1 1 draft approved specs/001-first/SPEC.md specs/001-first/SPEC.md SPEC.md",
+		"modified 2 2 approved approved specs/002-second/SPEC.md specs/002-second/SPEC.md PLAN.md",
+		"renamed 3 3 draft draft specs/003-third/SPEC.md specs/003-renamed/SPEC.md SPEC.md",
+		"removed 4.1  draft  specs/004-fourth/001-child/SPEC.md  SPEC.md",
+		"added  5  draft  specs/005-fifth/SPEC.md SPEC.md",
+		"renamed 6 6 draft approved specs/006-short/SPEC.md specs/006-brief/SPEC.md SPEC.md",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("unexpected changes:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if changes[0].Name != "First" || changes[3].OldName != "Child" {
+		t.Errorf("unexpected names: %+v", changes)
+	}
+
+	if _, err := Changes(filepath.Join(dir, "specs"), "no-such-branch", "HEAD"); err == nil {
+		t.Error("expected an error for an unknown revision")
+	}
+}
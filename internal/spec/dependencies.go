@@ -0,0 +1,60 @@
+package spec
+
+// Dependencies returns the specs that must be completed before node can
+// start: the refs in its depends_on list plus every spec whose blocks list
+// names it. Refs that do not resolve to a spec are returned in missing.
+func (t *Tree) Dependencies(node *Node) (deps []*Node, missing []string) {
+	seen := make(map[*Node]bool)
+	add := func(dep *Node) {
+		if dep != node && !seen[dep] {
+			seen[dep] = true
+			deps = append(deps, dep)
+		}
+	}
+
+	for _, ref := range node.Spec.DependsOn {
+		dep, err := t.Lookup(ref)
+		if err != nil {
+			missing = append(missing, ref)
+			continue
+		}
+		add(dep)
+	}
+
+	for _, other := range t.Nodes() {
+		for _, ref := range other.Spec.Blocks {
+			if blocked, err := t.Lookup(ref); err == nil && blocked == node {
+				add(other)
+				break
+			}
+		}
+	}
+
+	return deps, missing
+}
+
+// IsReady reports whether node is approved, not superseded, and every spec it
+// depends on is completed. A dependency that cannot be resolved keeps the
+// spec not ready.
+func (t *Tree) IsReady(node *Node) bool {
+	if node.Spec.Status != "approved" || t.IsSuperseded(node) {
+		return false
+	}
+
+	deps, missing := t.Dependencies(node)
+	if len(missing) > 0 {
+		return false
+	}
+	for _, dep := range deps {
+		if dep.Spec.Status != "completed" {
+			return false
+		}
+	}
+	return true
+}
+
+// NodeForSpec returns the node holding info, or nil when info did not come
+// from this tree.
+func (t *Tree) NodeForSpec(info *SpecInfo) *Node {
+	return t.bySpec[info]
+}
@@ -0,0 +1,56 @@
+package spec
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/specture-system/specture/internal/config"
+)
+
+// SpecsDirName is the conventional name of the specs directory.
+const SpecsDirName = "specs"
+
+// FindSpecsDir discovers the specs directory for a command started in
+// startDir. It walks up from startDir and returns the specs/ directory of the
+// nearest ancestor that contains specs/ or a project config file. The walk
+// stops at the root of the enclosing git repository. When nothing is found,
+// the repository root's specs/ is returned, or startDir's when startDir is
+// not inside a git repository, so callers report a missing specs directory
+// where one would be expected.
+func FindSpecsDir(startDir string) (string, error) {
+	dir, err := filepath.Abs(startDir)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve directory: %w", err)
+	}
+
+	fallback := filepath.Join(dir, SpecsDirName)
+	for {
+		if isProjectRoot(dir) {
+			return filepath.Join(dir, SpecsDirName), nil
+		}
+		if exists(filepath.Join(dir, ".git")) {
+			return filepath.Join(dir, SpecsDirName), nil
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return fallback, nil
+		}
+		dir = parent
+	}
+}
+
+// isProjectRoot reports whether dir holds a specs directory or a Specture
+// config file.
+func isProjectRoot(dir string) bool {
+	if info, err := os.Stat(filepath.Join(dir, SpecsDirName)); err == nil && info.IsDir() {
+		return true
+	}
+	return exists(filepath.Join(dir, config.FileName))
+}
+
+func exists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
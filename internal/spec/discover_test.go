@@ -0,0 +1,80 @@
+package spec
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/specture-system/specture/internal/config"
+)
+
+func TestFindSpecsDir(t *testing.T) {
+	mkdir := func(t *testing.T, path string) string {
+		t.Helper()
+		if err := os.MkdirAll(path, 0o755); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	t.Run("walks up to the nearest specs directory", func(t *testing.T) {
+		root := t.TempDir()
+		mkdir(t, filepath.Join(root, "specs", "001-feature"))
+		start := mkdir(t, filepath.Join(root, "internal", "pkg"))
+
+		got, err := FindSpecsDir(start)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if want := filepath.Join(root, "specs"); got != want {
+			t.Errorf("expected %s, got %s", want, got)
+		}
+	})
+
+	t.Run("config file marks the project root", func(t *testing.T) {
+		root := t.TempDir()
+		if err := os.WriteFile(filepath.Join(root, config.FileName), nil, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		start := mkdir(t, filepath.Join(root, "docs"))
+
+		got, err := FindSpecsDir(start)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if want := filepath.Join(root, "specs"); got != want {
+			t.Errorf("expected %s, got %s", want, got)
+		}
+	})
+
+	t.Run("stops at the git root", func(t *testing.T) {
+		outer := t.TempDir()
+		mkdir(t, filepath.Join(outer, "specs"))
+		repo := mkdir(t, filepath.Join(outer, "repo"))
+		mkdir(t, filepath.Join(repo, ".git"))
+		start := mkdir(t, filepath.Join(repo, "cmd"))
+
+		got, err := FindSpecsDir(start)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if want := filepath.Join(repo, "specs"); got != want {
+			t.Errorf("expected git root specs dir %s, got %s", want, got)
+		}
+	})
+
+	t.Run("prefers the specs directory at the start", func(t *testing.T) {
+		root := t.TempDir()
+		mkdir(t, filepath.Join(root, "specs"))
+		nested := mkdir(t, filepath.Join(root, "sub"))
+		mkdir(t, filepath.Join(nested, "specs"))
+
+		got, err := FindSpecsDir(nested)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if want := filepath.Join(nested, "specs"); got != want {
+			t.Errorf("expected %s, got %s", want, got)
+		}
+	})
+}
@@ -0,0 +1,123 @@
+package spec
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// FrontmatterField is a top-level frontmatter key and the scalar value to
+// store under it.
+type FrontmatterField struct {
+	Key   string
+	Value string
+}
+
+var (
+	plainDatePattern = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+	// trailingCommentPattern matches a YAML comment after a plain scalar.
+	trailingCommentPattern = regexp.MustCompile(`\s+#.*$`)
+)
+
+// SetFrontmatterFields rewrites top-level scalar fields in the frontmatter
+// of content, leaving every other line untouched so key order, comments, and
+// formatting survive. Existing keys are updated in place, keeping any
+// trailing comment; missing keys are inserted after the last updated or
+// inserted key, or at the end of the frontmatter.
+func SetFrontmatterFields(content []byte, fields []FrontmatterField) ([]byte, error) {
+	lines := strings.SplitAfter(string(content), "\n")
+	if len(lines) == 0 || strings.TrimRight(lines[0], "\r\n") != "---" {
+		return nil, fmt.Errorf("spec has no frontmatter")
+	}
+	end := -1
+	for i := 1; i < len(lines); i++ {
+		if strings.TrimRight(lines[i], "\r\n") == "---" {
+			end = i
+			break
+		}
+	}
+	if end < 0 {
+		return nil, fmt.Errorf("spec frontmatter is not closed")
+	}
+
+	newline := "\n"
+	if strings.HasSuffix(lines[0], "\r\n") {
+		newline = "\r\n"
+	}
+
+	insertAt := end
+	for _, field := range fields {
+		value, err := formatScalar(field.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		idx := findTopLevelKey(lines[1:end], field.Key)
+		if idx >= 0 {
+			line := lines[1+idx]
+			comment := ""
+			rest := strings.TrimRight(line[len(field.Key)+1:], "\r\n")
+			if match := trailingCommentPattern.FindString(rest); match != "" && !strings.ContainsAny(rest, `"'`) {
+				comment = match
+			}
+			lines[1+idx] = field.Key + ": " + value + comment + newline
+			// Drop a block value continued on indented lines below the key.
+			next := 2 + idx
+			for next < end && isContinuationLine(lines[next]) {
+				lines = append(lines[:next], lines[next+1:]...)
+				end--
+			}
+			insertAt = 2 + idx
+			continue
+		}
+
+		line := field.Key + ": " + value + newline
+		lines = append(lines[:insertAt], append([]string{line}, lines[insertAt:]...)...)
+		end++
+		insertAt++
+	}
+
+	return []byte(strings.Join(lines, "")), nil
+}
+
+// findTopLevelKey returns the index of the line defining key at the top
+// level of the frontmatter lines, or -1.
+func findTopLevelKey(lines []string, key string) int {
+	for i, line := range lines {
+		if strings.HasPrefix(line, key+":") {
+			return i
+		}
+	}
+	return -1
+}
+
+// isContinuationLine reports whether line continues the value of the key
+// above it.
+func isContinuationLine(line string) bool {
+	return strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t") || strings.HasPrefix(line, "- ")
+}
+
+// formatScalar renders value as a YAML scalar, quoting only when a plain
+// scalar would be read back as something else. Dates stay unquoted to match
+// the frontmatter written by specture new.
+func formatScalar(value string) (string, error) {
+	if plainDatePattern.MatchString(value) {
+		return value, nil
+	}
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	if err := encoder.Encode(value); err != nil {
+		return "", fmt.Errorf("failed to encode %q: %w", value, err)
+	}
+	if err := encoder.Close(); err != nil {
+		return "", fmt.Errorf("failed to encode %q: %w", value, err)
+	}
+	encoded := strings.TrimSuffix(buf.String(), "\n")
+	if strings.Contains(encoded, "\n") {
+		return "", fmt.Errorf("frontmatter value must be a single line: %q", value)
+	}
+	return encoded, nil
+}
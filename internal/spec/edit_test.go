@@ -0,0 +1,56 @@
+package spec
+
+import "testing"
+
+func TestSetFrontmatterFields(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		fields  []FrontmatterField
+		want    string
+	}{
+		{
+			name:    "updates in place and keeps comments",
+			content: "---\n# workflow state\nstatus: draft # set by hand\nauthor: Alice\n---\n\n# Spec\n",
+			fields:  []FrontmatterField{{Key: "status", Value: "approved"}},
+			want:    "---\n# workflow state\nstatus: approved # set by hand\nauthor: Alice\n---\n\n# Spec\n",
+		},
+		{
+			name:    "inserts missing keys after the updated key",
+			content: "---\nstatus: draft\nauthor: Alice\n---\n\n# Spec\n",
+			fields: []FrontmatterField{
+				{Key: "status", Value: "approved"},
+				{Key: "approved_by", Value: "Bob: Reviewer"},
+				{Key: "approval_date", Value: "2026-01-02"},
+			},
+			want: "---\nstatus: approved\napproved_by: 'Bob: Reviewer'\napproval_date: 2026-01-02\nauthor: Alice\n---\n\n# Spec\n",
+		},
+		{
+			name:    "appends keys missing from the frontmatter",
+			content: "---\nauthor: Alice\n---\n\n# Spec\n",
+			fields:  []FrontmatterField{{Key: "status", Value: "draft"}},
+			want:    "---\nauthor: Alice\nstatus: draft\n---\n\n# Spec\n",
+		},
+		{
+			name:    "replaces block values",
+			content: "---\napproved_by:\n  - Alice\n  - Bob\nstatus: draft\n---\n",
+			fields:  []FrontmatterField{{Key: "approved_by", Value: "Carol"}},
+			want:    "---\napproved_by: Carol\nstatus: draft\n---\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SetFrontmatterFields([]byte(tt.content), tt.fields)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("got:\n%s\nwant:\n%s", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := SetFrontmatterFields([]byte("# No frontmatter\n"), []FrontmatterField{{Key: "status", Value: "draft"}}); err == nil {
+		t.Error("expected error for content without frontmatter")
+	}
+}
@@ -0,0 +1,102 @@
+package spec
+
+import (
+	"encoding/json"
+	"math"
+
+	"gopkg.in/yaml.v3"
+)
+
+// maxExactFloat is the largest magnitude below which every whole float64 is
+// an exact integer.
+const maxExactFloat = 1 << 53
+
+// extraValue converts a frontmatter node to the value kept in
+// SpecInfo.Extra. Strings, dates, and other scalars keep their source text,
+// so `target: 2026-03-01` stays "2026-03-01". Whole numbers become int and
+// other numbers float64, which is also what cachedExtraValue makes of them,
+// so parsed and cached specs hold the same Go types.
+func extraValue(node *yaml.Node) any {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return nil
+		}
+		return extraValue(node.Content[0])
+	case yaml.AliasNode:
+		return extraValue(node.Alias)
+	case yaml.SequenceNode:
+		values := make([]any, len(node.Content))
+		for i, item := range node.Content {
+			values[i] = extraValue(item)
+		}
+		return values
+	case yaml.MappingNode:
+		values := make(map[string]any, len(node.Content)/2)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			values[node.Content[i].Value] = extraValue(node.Content[i+1])
+		}
+		return values
+	}
+
+	switch node.ShortTag() {
+	case "!!null":
+		return nil
+	case "!!bool":
+		var value bool
+		if err := node.Decode(&value); err == nil {
+			return value
+		}
+	case "!!int", "!!float":
+		var value any
+		if err := node.Decode(&value); err != nil {
+			break
+		}
+		switch v := value.(type) {
+		case int:
+			return v
+		case int64:
+			return extraNumber(float64(v))
+		case uint64:
+			return extraNumber(float64(v))
+		case float64:
+			if !math.IsInf(v, 0) && !math.IsNaN(v) {
+				return extraNumber(v)
+			}
+		}
+	}
+	return node.Value
+}
+
+// extraNumber returns f as an int when it is a whole number that float64
+// represents exactly, and as a float64 otherwise.
+func extraNumber(f float64) any {
+	if f == math.Trunc(f) && math.Abs(f) < maxExactFloat {
+		return int(f)
+	}
+	return f
+}
+
+// cachedExtraValue converts an Extra value decoded from the cache with
+// json.Decoder.UseNumber back to the types extraValue produces.
+func cachedExtraValue(value any) any {
+	switch v := value.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return int(i)
+		}
+		if f, err := v.Float64(); err == nil {
+			return extraNumber(f)
+		}
+		return v.String()
+	case []any:
+		for i, item := range v {
+			v[i] = cachedExtraValue(item)
+		}
+	case map[string]any:
+		for key, item := range v {
+			v[key] = cachedExtraValue(item)
+		}
+	}
+	return value
+}
@@ -0,0 +1,62 @@
+package spec
+
+import (
+	"path"
+	"path/filepath"
+
+	"github.com/specture-system/specture/internal/git"
+)
+
+// LoadHistory sets the git-derived CreatedAt, CreatedBy, UpdatedAt, and
+// UpdatedBy of every spec in the tree, or only of nodes when given, which
+// reads the history of just their files. Outside a git repository it leaves
+// them zero.
+func (t *Tree) LoadHistory(nodes ...*Node) error {
+	var paths []string
+	if len(nodes) > 0 {
+		for _, node := range nodes {
+			rel, ok := t.relPath(node)
+			if !ok {
+				continue
+			}
+			paths = append(paths, rel)
+			if plan := path.Join(path.Dir(rel), planFilename); plan != rel {
+				paths = append(paths, plan)
+			}
+		}
+	} else {
+		nodes = t.nodes
+	}
+	history, err := git.History(t.SpecsDir, paths...)
+	if err != nil {
+		return err
+	}
+
+	for _, node := range nodes {
+		rel, ok := t.relPath(node)
+		if !ok {
+			continue
+		}
+		info := node.Spec
+		if entry, ok := history[rel]; ok {
+			info.CreatedAt, info.CreatedBy = entry.First.Date, entry.First.Author
+			info.UpdatedAt, info.UpdatedBy = entry.Last.Date, entry.Last.Author
+		}
+		// Work on a spec often lands only in its plan, so plan commits count
+		// as updates too.
+		if entry, ok := history[path.Join(path.Dir(rel), planFilename)]; ok && entry.Last.Date.After(info.UpdatedAt) {
+			info.UpdatedAt, info.UpdatedBy = entry.Last.Date, entry.Last.Author
+		}
+	}
+	return nil
+}
+
+// relPath returns the path of node's spec file relative to the specs
+// directory, with forward slashes.
+func (t *Tree) relPath(node *Node) (string, bool) {
+	rel, err := filepath.Rel(t.SpecsDir, node.FilePath)
+	if err != nil {
+		return "", false
+	}
+	return filepath.ToSlash(rel), true
+}
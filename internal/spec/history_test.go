@@ -0,0 +1,69 @@
+package spec
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/specture-system/specture/internal/testhelpers"
+)
+
+func TestTree_LoadHistory(t *testing.T) {
+	dir := t.TempDir()
+	testhelpers.InitGitRepo(t, dir)
+	commit := func(date, author string) {
+		t.Helper()
+		for _, args := range [][]string{
+			{"add", "-A"},
+			{"commit", "-m", "change", "--date", date, "--author", author + " <" + author + "@example.com>"},
+		} {
+			if err := testhelpers.RunGitCommand(dir, args); err != nil {
+				t.Fatalf("git %v failed: %v", args, err)
+			}
+		}
+	}
+
+	testhelpers.WriteFile(t, dir, "specs/001-feature/SPEC.md", "---\nstatus: draft\n---\n\n# Feature\n")
+	testhelpers.WriteFile(t, dir, "specs/002-other/SPEC.md", "---\nstatus: draft\n---\n\n# Other\n")
+	commit("2026-01-01T10:00:00Z", "Alice")
+	testhelpers.WriteFile(t, dir, "specs/001-feature/PLAN.md", "# Plan\n")
+	commit("2026-02-01T10:00:00Z", "Bob")
+	testhelpers.WriteFile(t, dir, "specs/003-new/SPEC.md", "# New\n")
+
+	tree, err := BuildTree(filepath.Join(dir, "specs"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tree.LoadHistory(); err != nil {
+		t.Fatalf("LoadHistory() error = %v", err)
+	}
+
+	feature := tree.byRef["1"].Spec
+	if feature.CreatedBy != "Alice" || feature.CreatedAt.Format("2006-01-02") != "2026-01-01" {
+		t.Errorf("spec 1 created = %v by %q, want 2026-01-01 by Alice", feature.CreatedAt, feature.CreatedBy)
+	}
+	if feature.UpdatedBy != "Bob" || !feature.LastUpdated().Equal(feature.UpdatedAt) {
+		t.Errorf("spec 1 updated by %q, want the plan commit by Bob", feature.UpdatedBy)
+	}
+	if other := tree.byRef["2"].Spec; other.UpdatedBy != "Alice" {
+		t.Errorf("spec 2 updated by %q, want Alice", other.UpdatedBy)
+	}
+	uncommitted := tree.byRef["3"].Spec
+	if !uncommitted.UpdatedAt.IsZero() || !uncommitted.LastUpdated().Equal(uncommitted.ModTime) {
+		t.Errorf("uncommitted spec should fall back to its modification time, got %v", uncommitted.LastUpdated())
+	}
+
+	// Loading the history of one spec leaves the others alone.
+	tree, err = BuildTree(filepath.Join(dir, "specs"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tree.LoadHistory(tree.byRef["1"]); err != nil {
+		t.Fatalf("LoadHistory(1) error = %v", err)
+	}
+	if feature := tree.byRef["1"].Spec; feature.CreatedBy != "Alice" || feature.UpdatedBy != "Bob" {
+		t.Errorf("spec 1 created by %q and updated by %q, want Alice and Bob", feature.CreatedBy, feature.UpdatedBy)
+	}
+	if other := tree.byRef["2"].Spec; !other.UpdatedAt.IsZero() {
+		t.Errorf("spec 2 history should not be loaded, got %v", other.UpdatedAt)
+	}
+}
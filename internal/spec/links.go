@@ -0,0 +1,168 @@
+package spec
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+)
+
+// Link is an outgoing markdown link from a spec to another spec file.
+type Link struct {
+	Text        string `json:"text"`
+	Destination string `json:"destination"`
+}
+
+// Reference is a resolved link between two specs in a Tree. To is nil when
+// the link destination does not match any spec in the tree.
+type Reference struct {
+	From *Node
+	To   *Node
+	Link Link
+}
+
+// extractLinks collects the markdown links that point at SPEC.md or PLAN.md
+// files, in document order.
+func extractLinks(doc ast.Node, source []byte) []Link {
+	var links []Link
+	ast.Walk(doc, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		link, ok := n.(*ast.Link)
+		if !ok {
+			return ast.WalkContinue, nil
+		}
+		destination := string(link.Destination)
+		if isSpecLinkDestination(destination) {
+			links = append(links, Link{
+				Text:        InlineText(link, source),
+				Destination: destination,
+			})
+		}
+		return ast.WalkSkipChildren, nil
+	})
+	return links
+}
+
+// isSpecLinkDestination reports whether a link destination is a local path to
+// a SPEC.md or PLAN.md file.
+func isSpecLinkDestination(destination string) bool {
+	if strings.Contains(destination, "://") || strings.HasPrefix(destination, "mailto:") {
+		return false
+	}
+	return IsSpecFilePath(stripLinkFragment(destination))
+}
+
+// stripLinkFragment removes any #fragment or ?query suffix from a link
+// destination.
+func stripLinkFragment(destination string) string {
+	if i := strings.IndexAny(destination, "#?"); i >= 0 {
+		return destination[:i]
+	}
+	return destination
+}
+
+// InlineText returns the plain text of a node's inline content, such as a
+// heading, link, or paragraph. Line breaks become spaces.
+func InlineText(n ast.Node, source []byte) string {
+	var buf bytes.Buffer
+	ast.Walk(n, func(child ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch t := child.(type) {
+		case *ast.Text:
+			buf.Write(t.Segment.Value(source))
+			if t.SoftLineBreak() || t.HardLineBreak() {
+				buf.WriteByte(' ')
+			}
+		case *ast.String:
+			buf.Write(t.Value)
+		}
+		return ast.WalkContinue, nil
+	})
+	return buf.String()
+}
+
+// ResolveLink returns the spec a link from node points at, or nil when the
+// destination does not match a spec in the tree. Destinations are tried as
+// repo-root-relative paths first, following the spec linking convention, and
+// then relative to the linking file.
+func (t *Tree) ResolveLink(from *Node, link Link) *Node {
+	destination := filepath.FromSlash(stripLinkFragment(link.Destination))
+	repoRoot := filepath.Dir(t.SpecsDir)
+
+	candidates := []string{filepath.Join(repoRoot, destination)}
+	if !strings.HasPrefix(link.Destination, "/") {
+		candidates = append(candidates, filepath.Join(filepath.Dir(from.FilePath), destination))
+	}
+	for _, candidate := range candidates {
+		if node := t.NodeForPath(candidate); node != nil {
+			return node
+		}
+	}
+	return nil
+}
+
+// Outbound returns the links from node to other specs, one per target spec in
+// first-mention order. Links that do not resolve are kept with a nil To, one
+// per distinct destination, so broken references stay visible.
+func (t *Tree) Outbound(node *Node) []Reference {
+	var refs []Reference
+	seenTargets := make(map[*Node]bool)
+	seenBroken := make(map[string]bool)
+	for _, link := range node.Spec.Links {
+		target := t.ResolveLink(node, link)
+		if target == node {
+			continue
+		}
+		if target == nil {
+			if seenBroken[link.Destination] {
+				continue
+			}
+			seenBroken[link.Destination] = true
+		} else {
+			if seenTargets[target] {
+				continue
+			}
+			seenTargets[target] = true
+		}
+		refs = append(refs, Reference{From: node, To: target, Link: link})
+	}
+	return refs
+}
+
+// Inbound returns the links from other specs to node ("backlinks"), one per
+// linking spec, ordered by the linking spec's position in the tree.
+func (t *Tree) Inbound(node *Node) []Reference {
+	var refs []Reference
+	for _, other := range t.Nodes() {
+		if other == node {
+			continue
+		}
+		for _, ref := range t.Outbound(other) {
+			if ref.To == node {
+				refs = append(refs, ref)
+				break
+			}
+		}
+	}
+	return refs
+}
+
+// InboundCounts returns the number of specs linking to each node, as
+// len(Inbound(node)) would, computed in one pass over the tree. Nodes
+// without backlinks are absent.
+func (t *Tree) InboundCounts() map[*Node]int {
+	counts := make(map[*Node]int)
+	for _, node := range t.Nodes() {
+		for _, ref := range t.Outbound(node) {
+			if ref.To != nil {
+				counts[ref.To]++
+			}
+		}
+	}
+	return counts
+}
@@ -0,0 +1,118 @@
+package spec
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseContent_Links(t *testing.T) {
+	body := `See [the ` + "`list`" + ` command](specs/004-list/SPEC.md) and [its plan](/specs/004-list/PLAN.md#tasks).
+
+Relative [child](001-child/SPEC.md), an [external page](https://example.com/SPEC.md), and a [README](README.md).
+`
+	info, err := ParseContent("001-test.md", buildSpec("status: draft", "Test", body))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []Link{
+		{Text: "the list command", Destination: "specs/004-list/SPEC.md"},
+		{Text: "its plan", Destination: "/specs/004-list/PLAN.md#tasks"},
+		{Text: "child", Destination: "001-child/SPEC.md"},
+	}
+	if len(info.Links) != len(want) {
+		t.Fatalf("expected %d links, got %+v", len(want), info.Links)
+	}
+	for i := range want {
+		if info.Links[i] != want[i] {
+			t.Errorf("link %d: expected %+v, got %+v", i, want[i], info.Links[i])
+		}
+	}
+}
+
+func setupLinksTest(t *testing.T) *Tree {
+	t.Helper()
+	specsDir := filepath.Join(t.TempDir(), "specs")
+	files := map[string]string{
+		"001-target/SPEC.md":           "# Target\n",
+		"001-target/001-child/SPEC.md": "# Child\n\nBack to [parent](../SPEC.md).\n",
+		"002-linker/SPEC.md": "# Linker\n\n" +
+			"Uses [target](specs/001-target/SPEC.md), [again](/specs/001-target/SPEC.md), " +
+			"[target plan](specs/001-target/PLAN.md), [self](specs/002-linker/SPEC.md), " +
+			"and [gone](specs/009-gone/SPEC.md) twice: [gone](specs/009-gone/SPEC.md).\n",
+		"003-other/SPEC.md": "# Other\n\nSee [child](specs/001-target/001-child/SPEC.md) and [target](specs/001-target/SPEC.md).\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(specsDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tree, err := BuildTree(specsDir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return tree
+}
+
+func mustLookup(t *testing.T, tree *Tree, ref string) *Node {
+	t.Helper()
+	node, err := tree.Lookup(ref)
+	if err != nil {
+		t.Fatalf("lookup %s: %v", ref, err)
+	}
+	return node
+}
+
+func TestTree_Outbound(t *testing.T) {
+	tree := setupLinksTest(t)
+	linker := mustLookup(t, tree, "2")
+
+	refs := tree.Outbound(linker)
+	if len(refs) != 2 {
+		t.Fatalf("expected one resolved and one broken link, got %+v", refs)
+	}
+	if refs[0].To != mustLookup(t, tree, "1") || refs[0].Link.Text != "target" {
+		t.Errorf("expected first link to target spec 1, got %+v", refs[0])
+	}
+	if refs[1].To != nil || refs[1].Link.Destination != "specs/009-gone/SPEC.md" {
+		t.Errorf("expected broken link to be kept once, got %+v", refs[1])
+	}
+
+	// Relative links resolve against the linking file.
+	child := mustLookup(t, tree, "1.1")
+	refs = tree.Outbound(child)
+	if len(refs) != 1 || refs[0].To != mustLookup(t, tree, "1") {
+		t.Errorf("expected relative link to resolve to spec 1, got %+v", refs)
+	}
+}
+
+func TestTree_Inbound(t *testing.T) {
+	tree := setupLinksTest(t)
+
+	refs := tree.Inbound(mustLookup(t, tree, "1"))
+	if len(refs) != 3 {
+		t.Fatalf("expected three backlinks, got %d", len(refs))
+	}
+	assertRefs(t, "backlinks", []*Node{refs[0].From, refs[1].From, refs[2].From}, "1.1", "2", "3")
+
+	if refs := tree.Inbound(mustLookup(t, tree, "2")); len(refs) != 0 {
+		t.Errorf("expected self links to be ignored, got %+v", refs)
+	}
+}
+
+func TestTree_InboundCounts(t *testing.T) {
+	tree := setupLinksTest(t)
+
+	counts := tree.InboundCounts()
+	for _, node := range tree.Nodes() {
+		if got, want := counts[node], len(tree.Inbound(node)); got != want {
+			t.Errorf("spec %s: expected %d backlinks, got %d", node.Spec.FullRef, want, got)
+		}
+	}
+}
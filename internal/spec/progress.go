@@ -0,0 +1,122 @@
+package spec
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/yuin/goldmark/ast"
+	extast "github.com/yuin/goldmark/extension/ast"
+)
+
+// Progress counts the task list checkboxes ("- [ ]" and "- [x]") in a
+// document or section.
+type Progress struct {
+	Done  int `json:"done"`
+	Total int `json:"total"`
+}
+
+// Add returns the sum of p and other.
+func (p Progress) Add(other Progress) Progress {
+	return Progress{Done: p.Done + other.Done, Total: p.Total + other.Total}
+}
+
+// Percent returns the share of done tasks as a whole percentage, rounded
+// down. It returns 0 when there are no tasks.
+func (p Progress) Percent() int {
+	if p.Total == 0 {
+		return 0
+	}
+	return p.Done * 100 / p.Total
+}
+
+// String formats p as "done/total".
+func (p Progress) String() string {
+	return fmt.Sprintf("%d/%d", p.Done, p.Total)
+}
+
+// SectionProgress is the task progress within one section of a document. A
+// section's counts include the tasks in its subsections.
+type SectionProgress struct {
+	Level int    `json:"level"`
+	Title string `json:"title"`
+	Progress
+}
+
+// TotalProgress returns the combined task progress of the spec document and
+// its plan.
+func (s *SpecInfo) TotalProgress() Progress {
+	return s.Progress.Add(s.PlanProgress)
+}
+
+// extractProgress counts the task checkboxes of a goldmark document, in total
+// and per H2-H6 section. Sections without tasks are omitted.
+func extractProgress(doc ast.Node, source []byte) (Progress, []SectionProgress) {
+	var total Progress
+	var sections []SectionProgress
+	// open holds the indexes of the sections enclosing the current block.
+	var open []int
+	for n := doc.FirstChild(); n != nil; n = n.NextSibling() {
+		if heading, ok := n.(*ast.Heading); ok {
+			if heading.Level < 2 {
+				continue
+			}
+			for len(open) > 0 && sections[open[len(open)-1]].Level >= heading.Level {
+				open = open[:len(open)-1]
+			}
+			sections = append(sections, SectionProgress{
+				Level: heading.Level,
+				Title: headingText(heading, source),
+			})
+			open = append(open, len(sections)-1)
+			continue
+		}
+
+		tasks := countTasks(n)
+		total = total.Add(tasks)
+		for _, i := range open {
+			sections[i].Progress = sections[i].Progress.Add(tasks)
+		}
+	}
+
+	var withTasks []SectionProgress
+	for _, section := range sections {
+		if section.Total > 0 {
+			withTasks = append(withTasks, section)
+		}
+	}
+	return total, withTasks
+}
+
+// countTasks counts the task checkboxes within n.
+func countTasks(n ast.Node) Progress {
+	var progress Progress
+	ast.Walk(n, func(child ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		if box, ok := child.(*extast.TaskCheckBox); ok {
+			progress.Total++
+			if box.IsChecked {
+				progress.Done++
+			}
+		}
+		return ast.WalkContinue, nil
+	})
+	return progress
+}
+
+// parsePlanProgress counts the tasks in the PLAN.md beside the spec file at
+// path. It returns zero progress when no plan exists.
+func parsePlanProgress(path string) (Progress, []SectionProgress, error) {
+	content, err := os.ReadFile(filepath.Join(filepath.Dir(path), planFilename))
+	if os.IsNotExist(err) {
+		return Progress{}, nil, nil
+	}
+	if err != nil {
+		return Progress{}, nil, fmt.Errorf("failed to read plan: %w", err)
+	}
+	doc, _ := ParseMarkdown(content)
+	progress, sections := extractProgress(doc, content)
+	return progress, sections, nil
+}
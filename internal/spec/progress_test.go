@@ -0,0 +1,116 @@
+package spec
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestParseContent_Progress(t *testing.T) {
+	body := `- [x] Intro task
+
+## Slices
+
+### PR 1: Parser
+
+- [x] Count tasks
+- [X] Count sections
+  - [ ] Nested task
+
+### PR 2: List
+
+- [ ] Add column
+- Plain list item
+
+## Notes
+
+No tasks here.
+`
+	info, err := ParseContent("specs/001-test/SPEC.md", buildSpec("status: draft", "Test", body))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if info.Progress != (Progress{Done: 3, Total: 5}) {
+		t.Errorf("expected progress 3/5, got %s", info.Progress)
+	}
+	want := []SectionProgress{
+		{Level: 2, Title: "Slices", Progress: Progress{Done: 2, Total: 4}},
+		{Level: 3, Title: "PR 1: Parser", Progress: Progress{Done: 2, Total: 3}},
+		{Level: 3, Title: "PR 2: List", Progress: Progress{Done: 0, Total: 1}},
+	}
+	if len(info.SectionProgress) != len(want) {
+		t.Fatalf("expected %d sections with tasks, got %+v", len(want), info.SectionProgress)
+	}
+	for i := range want {
+		if info.SectionProgress[i] != want[i] {
+			t.Errorf("section %d: expected %+v, got %+v", i, want[i], info.SectionProgress[i])
+		}
+	}
+	if info.PlanProgress.Total != 0 {
+		t.Errorf("expected no plan progress from ParseContent, got %s", info.PlanProgress)
+	}
+}
+
+func TestParse_PlanProgress(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "001-test")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	specPath := filepath.Join(dir, specFilename)
+	if err := os.WriteFile(specPath, buildSpec("status: in-progress", "Test", "- [x] Spec task\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	planPath := filepath.Join(dir, planFilename)
+	if err := os.WriteFile(planPath, []byte("# Plan\n\n### PR 1\n\n- [x] Done\n- [ ] Open\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := Parse(specPath)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if info.PlanProgress != (Progress{Done: 1, Total: 2}) {
+		t.Errorf("expected plan progress 1/2, got %s", info.PlanProgress)
+	}
+	if len(info.PlanSectionProgress) != 1 || info.PlanSectionProgress[0].Title != "PR 1" {
+		t.Errorf("expected PR 1 plan section, got %+v", info.PlanSectionProgress)
+	}
+	if got := info.TotalProgress(); got != (Progress{Done: 2, Total: 3}) || got.Percent() != 66 {
+		t.Errorf("expected total progress 2/3 (66%%), got %s (%d%%)", got, got.Percent())
+	}
+
+	planOnly, err := Parse(planPath)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if planOnly.Progress.Total != 0 || planOnly.PlanProgress != (Progress{Done: 1, Total: 2}) {
+		t.Errorf("expected a plan-only spec to report plan progress, got %s and %s", planOnly.Progress, planOnly.PlanProgress)
+	}
+}
+
+func TestParseAll_CacheTracksPlanChanges(t *testing.T) {
+	specsDir, specPath := setupCacheTest(t)
+
+	if _, err := ParseAll(specsDir); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	planPath := filepath.Join(filepath.Dir(specPath), planFilename)
+	if err := os.WriteFile(planPath, []byte("# Plan\n\n- [x] One\n- [ ] Two\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	future := time.Now().Add(time.Hour)
+	if err := os.Chtimes(planPath, future, future); err != nil {
+		t.Fatal(err)
+	}
+
+	specs, err := ParseAll(specsDir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if specs[0].PlanProgress != (Progress{Done: 1, Total: 2}) {
+		t.Errorf("expected plan change to invalidate the cache, got %s", specs[0].PlanProgress)
+	}
+}
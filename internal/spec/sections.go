@@ -0,0 +1,97 @@
+package spec
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/yuin/goldmark/ast"
+)
+
+// HeadingSpan is a top-level heading and the byte range of its section: the
+// heading line through the line before the next heading at the same or a
+// higher level.
+type HeadingSpan struct {
+	Section
+	Heading *ast.Heading
+	Start   int
+	End     int
+}
+
+// Markdown returns the section's markdown from content, which the span was
+// located in, without trailing blank lines.
+func (s HeadingSpan) Markdown(content []byte) []byte {
+	section := bytes.TrimRight(content[s.Start:s.End], " \t\r\n")
+	return append(section[:len(section):len(section)], '\n')
+}
+
+// Headings returns every H2-H6 heading of a spec document in document order.
+// Unlike SpecInfo.Sections it includes headings below H3.
+func Headings(content []byte) []Section {
+	doc, _ := ParseMarkdown(content)
+	spans := HeadingSpans(doc, content)
+	sections := make([]Section, 0, len(spans))
+	for _, span := range spans {
+		if span.Level >= 2 {
+			sections = append(sections, span.Section)
+		}
+	}
+	return sections
+}
+
+// ExtractSection returns the markdown of the first section whose heading
+// matches title, ignoring case and surrounding whitespace. The result starts
+// with the heading line and includes nested subsections, stopping before the
+// next heading at the same or a higher level. Trailing blank lines are
+// dropped.
+func ExtractSection(content []byte, title string) ([]byte, error) {
+	want := strings.TrimSpace(title)
+	doc, _ := ParseMarkdown(content)
+	spans := HeadingSpans(doc, content)
+	for _, span := range spans {
+		if strings.EqualFold(strings.TrimSpace(span.Title), want) {
+			return span.Markdown(content), nil
+		}
+	}
+
+	var available []string
+	for _, span := range spans {
+		if span.Level >= 2 {
+			available = append(available, span.Title)
+		}
+	}
+	if len(available) == 0 {
+		return nil, fmt.Errorf("section %q not found (spec has no sections)", want)
+	}
+	return nil, fmt.Errorf("section %q not found (available: %s)", want, strings.Join(available, ", "))
+}
+
+// HeadingSpans locates the top-level headings of doc, a document parsed from
+// content with ParseMarkdown. Headings without text cannot be located in the
+// source and are skipped.
+func HeadingSpans(doc ast.Node, content []byte) []HeadingSpan {
+	var spans []HeadingSpan
+	for n := doc.FirstChild(); n != nil; n = n.NextSibling() {
+		heading, ok := n.(*ast.Heading)
+		if !ok || heading.Lines().Len() == 0 {
+			continue
+		}
+		textStart := heading.Lines().At(0).Start
+		spans = append(spans, HeadingSpan{
+			Section: Section{Level: heading.Level, Title: headingText(heading, content)},
+			Heading: heading,
+			Start:   bytes.LastIndexByte(content[:textStart], '\n') + 1,
+			End:     len(content),
+		})
+	}
+
+	for i := range spans {
+		for _, next := range spans[i+1:] {
+			if next.Level <= spans[i].Level {
+				spans[i].End = next.Start
+				break
+			}
+		}
+	}
+	return spans
+}
@@ -0,0 +1,83 @@
+package spec
+
+import (
+	"strings"
+	"testing"
+)
+
+const sectionsContent = `---
+status: draft
+---
+
+# Title
+
+Intro.
+
+## Goals
+
+Ship it.
+
+### Stretch *Goals*
+
+More.
+
+#### Detail
+
+Deep.
+
+## Design Decisions
+
+` + "```md\n## Not a heading\n```\n" + `
+Use the AST.
+`
+
+func TestExtractSection(t *testing.T) {
+	tests := []struct {
+		title string
+		want  string
+	}{
+		{"Goals", "## Goals\n\nShip it.\n\n### Stretch *Goals*\n\nMore.\n\n#### Detail\n\nDeep.\n"},
+		{"  stretch goals ", "### Stretch *Goals*\n\nMore.\n\n#### Detail\n\nDeep.\n"},
+		{"Detail", "#### Detail\n\nDeep.\n"},
+		{"Design Decisions", "## Design Decisions\n\n```md\n## Not a heading\n```\n\nUse the AST.\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.title, func(t *testing.T) {
+			got, err := ExtractSection([]byte(sectionsContent), tt.title)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("ExtractSection(%q) = %q, want %q", tt.title, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtractSection_NotFound(t *testing.T) {
+	_, err := ExtractSection([]byte(sectionsContent), "Not a heading")
+	if err == nil {
+		t.Fatal("expected error for missing section")
+	}
+	if !strings.Contains(err.Error(), "available: Goals, Stretch Goals, Detail, Design Decisions") {
+		t.Errorf("expected available sections in error, got %v", err)
+	}
+
+	_, err = ExtractSection([]byte("# Only a title\n"), "Goals")
+	if err == nil || !strings.Contains(err.Error(), "spec has no sections") {
+		t.Errorf("expected no sections error, got %v", err)
+	}
+}
+
+func TestHeadings(t *testing.T) {
+	headings := Headings([]byte(sectionsContent))
+	var got []string
+	for _, heading := range headings {
+		got = append(got, strings.Repeat("#", heading.Level)+" "+heading.Title)
+	}
+	want := "## Goals|### Stretch Goals|#### Detail|## Design Decisions"
+	if strings.Join(got, "|") != want {
+		t.Errorf("expected headings %s, got %s", want, strings.Join(got, "|"))
+	}
+}
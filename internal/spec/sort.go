@@ -0,0 +1,137 @@
+package spec
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// SortKeys lists the keys accepted by SortSpecs.
+var SortKeys = []string{"ref", "name", "status", "assignee", "priority", "created", "updated"}
+
+// SortOptions configures SortSpecs.
+type SortOptions struct {
+	// Key is one of SortKeys.
+	Key     string
+	Reverse bool
+	// Statuses orders the status key. Statuses that are not listed sort
+	// after the listed ones, alphabetically.
+	Statuses []string
+	// Priorities orders the priority key, highest first. Priorities that
+	// are not listed sort after the listed ones, alphabetically.
+	Priorities []string
+}
+
+// sortKey compares two specs by one key. Specs for which missing reports
+// true have no value for the key.
+type sortKey struct {
+	compare func(a, b *SpecInfo) int
+	missing func(s *SpecInfo) bool
+}
+
+// SortSpecs sorts specs in place by opts.Key, ascending unless opts.Reverse
+// is set. Specs with equal values keep ref order, and specs without a value
+// for the key (no assignee, priority, creation date, ...) sort last in either
+// direction.
+func SortSpecs(specs []*SpecInfo, opts SortOptions) error {
+	key, err := newSortKey(opts)
+	if err != nil {
+		return err
+	}
+
+	slices.SortStableFunc(specs, func(a, b *SpecInfo) int {
+		aMissing, bMissing := key.missing(a), key.missing(b)
+		switch {
+		case aMissing && !bMissing:
+			return 1
+		case !aMissing && bMissing:
+			return -1
+		case !aMissing:
+			c := key.compare(a, b)
+			if opts.Reverse {
+				c = -c
+			}
+			if c != 0 {
+				return c
+			}
+		}
+		return compareRefs(a.FullRef, b.FullRef)
+	})
+	return nil
+}
+
+// newSortKey returns the comparison for opts.Key.
+func newSortKey(opts SortOptions) (sortKey, error) {
+	never := func(*SpecInfo) bool { return false }
+	switch opts.Key {
+	case "", "ref":
+		return sortKey{
+			compare: func(a, b *SpecInfo) int { return compareRefs(a.FullRef, b.FullRef) },
+			missing: never,
+		}, nil
+	case "name":
+		return sortKey{
+			compare: func(a, b *SpecInfo) int {
+				return strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+			},
+			missing: func(s *SpecInfo) bool { return s.Name == "" },
+		}, nil
+	case "status":
+		return sortKey{
+			compare: func(a, b *SpecInfo) int { return CompareRanked(opts.Statuses, a.Status, b.Status) },
+			missing: func(s *SpecInfo) bool { return s.Status == "" },
+		}, nil
+	case "assignee":
+		return sortKey{
+			compare: func(a, b *SpecInfo) int {
+				return strings.Compare(strings.ToLower(a.AssigneeList()), strings.ToLower(b.AssigneeList()))
+			},
+			missing: func(s *SpecInfo) bool { return len(s.Assignees) == 0 },
+		}, nil
+	case "priority":
+		return sortKey{
+			compare: func(a, b *SpecInfo) int { return CompareRanked(opts.Priorities, a.Priority, b.Priority) },
+			missing: func(s *SpecInfo) bool { return s.Priority == "" },
+		}, nil
+	case "created":
+		return sortKey{
+			compare: func(a, b *SpecInfo) int { return strings.Compare(a.CreationDate, b.CreationDate) },
+			missing: func(s *SpecInfo) bool { return s.CreationDate == "" },
+		}, nil
+	case "updated":
+		return sortKey{
+			compare: func(a, b *SpecInfo) int { return a.LastUpdated().Compare(b.LastUpdated()) },
+			missing: func(s *SpecInfo) bool { return s.LastUpdated().IsZero() },
+		}, nil
+	default:
+		return sortKey{}, fmt.Errorf("invalid sort key: %s (must be one of: %s)", opts.Key, strings.Join(SortKeys, ", "))
+	}
+}
+
+// compareRefs orders dotted refs numerically, as compareFullRefs does.
+func compareRefs(a, b string) int {
+	switch {
+	case compareFullRefs(a, b):
+		return -1
+	case compareFullRefs(b, a):
+		return 1
+	default:
+		return 0
+	}
+}
+
+// CompareRanked orders values by their position in order. Values missing
+// from order sort after listed ones, alphabetically.
+func CompareRanked(order []string, a, b string) int {
+	rank := func(value string) int {
+		if i := slices.Index(order, value); i >= 0 {
+			return i
+		}
+		return len(order)
+	}
+	if c := cmp.Compare(rank(a), rank(b)); c != 0 {
+		return c
+	}
+	return strings.Compare(a, b)
+}
@@ -0,0 +1,74 @@
+package spec
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func sortedRefs(t *testing.T, specs []*SpecInfo, opts SortOptions) string {
+	t.Helper()
+	sorted := append([]*SpecInfo(nil), specs...)
+	if err := SortSpecs(sorted, opts); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	refs := make([]string, len(sorted))
+	for i, spec := range sorted {
+		refs[i] = spec.FullRef
+	}
+	return strings.Join(refs, " ")
+}
+
+func TestSortSpecs(t *testing.T) {
+	day := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	specs := []*SpecInfo{
+		{FullRef: "10", Name: "alpha", Status: "draft", Priority: "low", CreationDate: "2026-03-01", ModTime: day.Add(48 * time.Hour)},
+		{FullRef: "2", Name: "Charlie", Status: "completed", Assignees: []string{"bob"}, CreationDate: "2026-01-01", ModTime: day, UpdatedAt: day.Add(72 * time.Hour)},
+		{FullRef: "2.1", Name: "bravo", Status: "in-progress", Priority: "critical", Assignees: []string{"Alice"}},
+		{FullRef: "3", Name: "delta", Status: "approved", Priority: "high", ModTime: day.Add(24 * time.Hour)},
+	}
+	statuses := []string{"draft", "approved", "in-progress", "completed"}
+	priorities := []string{"critical", "high", "medium", "low"}
+
+	tests := []struct {
+		key     string
+		reverse bool
+		want    string
+	}{
+		{"ref", false, "2 2.1 3 10"},
+		{"ref", true, "10 3 2.1 2"},
+		{"name", false, "10 2.1 2 3"},
+		{"status", false, "10 3 2.1 2"},
+		{"assignee", false, "2.1 2 3 10"},
+		{"priority", false, "2.1 3 10 2"},
+		{"priority", true, "10 3 2.1 2"},
+		{"created", false, "2 10 2.1 3"},
+		{"updated", true, "2 10 3 2.1"},
+		{"updated", false, "3 10 2 2.1"},
+	}
+	for _, tt := range tests {
+		opts := SortOptions{Key: tt.key, Reverse: tt.reverse, Statuses: statuses, Priorities: priorities}
+		if got := sortedRefs(t, specs, opts); got != tt.want {
+			t.Errorf("sort by %s (reverse %v): expected %q, got %q", tt.key, tt.reverse, tt.want, got)
+		}
+	}
+}
+
+func TestSortSpecs_UnlistedValuesSortAfterListed(t *testing.T) {
+	specs := []*SpecInfo{
+		{FullRef: "1", Priority: "urgent"},
+		{FullRef: "2", Priority: "low"},
+		{FullRef: "3", Priority: "blocker"},
+	}
+	got := sortedRefs(t, specs, SortOptions{Key: "priority", Priorities: []string{"high", "low"}})
+	if got != "2 3 1" {
+		t.Errorf("expected unlisted priorities after listed ones, got %q", got)
+	}
+}
+
+func TestSortSpecs_InvalidKey(t *testing.T) {
+	err := SortSpecs(nil, SortOptions{Key: "size"})
+	if err == nil || !strings.Contains(err.Error(), "invalid sort key: size") {
+		t.Errorf("expected invalid sort key error, got %v", err)
+	}
+}
@@ -2,7 +2,6 @@
 package spec
 
 import (
-	"bytes"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -10,12 +9,15 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/yuin/goldmark"
 	"github.com/yuin/goldmark/ast"
+	"github.com/yuin/goldmark/extension"
 	"github.com/yuin/goldmark/parser"
 	"github.com/yuin/goldmark/text"
 	gmfrontmatter "go.abhg.dev/goldmark/frontmatter"
+	"gopkg.in/yaml.v3"
 )
 
 const (
@@ -25,18 +27,121 @@ const (
 
 // SpecInfo represents a parsed spec file with all extracted metadata.
 type SpecInfo struct {
-	Path     string
-	Name     string
-	Number   int
-	FullRef  string
-	Status   string
-	Assignee string
+	Path    string
+	Name    string
+	Number  int
+	FullRef string
+	Status  string
+	// Assignees lists the people assigned to the spec. The assignee
+	// frontmatter may hold a single name or a list of names.
+	Assignees    []string
+	Author       string
+	CreationDate string
+	ApprovedBy   string
+	ApprovalDate string
+	// Labels lists the spec's labels, such as the areas it covers.
+	Labels []string
+	// Priority is one of the configured priorities, such as "high".
+	Priority string
+	// Milestone names the release or target the spec is planned for.
+	Milestone string
+	// Extra holds frontmatter keys that have no dedicated field above, so
+	// project-specific metadata survives parsing.
+	Extra map[string]any
+	// Sections lists the H2 and H3 headings in document order.
+	Sections []Section
+	// HasPlan reports whether a PLAN.md file exists in the spec directory.
+	HasPlan bool
+	// Links lists the outgoing markdown links to other spec files.
+	Links []Link
+	// DependsOn lists refs of specs that must be completed before this one
+	// can start, as written in frontmatter.
+	DependsOn []string
+	// Blocks lists refs of specs that cannot start until this one is
+	// completed, as written in frontmatter.
+	Blocks []string
+	// Supersedes lists refs of specs this one replaces, as written in
+	// frontmatter.
+	Supersedes []string
+	// SupersededBy lists refs of specs that replace this one, as written in
+	// frontmatter.
+	SupersededBy []string
+	// Progress counts the task list items in the spec document, and
+	// SectionProgress breaks it down by the sections that contain tasks.
+	Progress        Progress
+	SectionProgress []SectionProgress
+	// PlanProgress and PlanSectionProgress count the task list items in the
+	// spec's PLAN.md the same way.
+	PlanProgress        Progress
+	PlanSectionProgress []SectionProgress
+	// ModTime is the latest modification time of the spec file and the
+	// PLAN.md beside it. Parse sets it; ParseContent leaves it zero.
+	ModTime time.Time
+	// CreatedAt and CreatedBy are the date and author of the first commit
+	// of the spec file, following renames. UpdatedAt and UpdatedBy are those
+	// of the latest commit to the spec file or its PLAN.md. Tree.LoadHistory
+	// sets them; they stay zero for uncommitted specs and outside git. They
+	// change without the file changing, so they are never cached.
+	CreatedAt time.Time `json:"-"`
+	CreatedBy string    `json:"-"`
+	UpdatedAt time.Time `json:"-"`
+	UpdatedBy string    `json:"-"`
+}
+
+// LastUpdated returns when the spec was last changed: its latest commit, or
+// its modification time when it has never been committed.
+func (s *SpecInfo) LastUpdated() time.Time {
+	if !s.UpdatedAt.IsZero() {
+		return s.UpdatedAt
+	}
+	return s.ModTime
+}
+
+// AssigneeList returns the assignees joined with ", ", or an empty string
+// when the spec is unassigned.
+func (s *SpecInfo) AssigneeList() string {
+	return strings.Join(s.Assignees, ", ")
+}
+
+// Section is a heading within a spec document.
+type Section struct {
+	Level int    `json:"level"`
+	Title string `json:"title"`
 }
 
 // frontmatter represents the YAML frontmatter of a spec.
 type frontmatter struct {
-	Status   string `yaml:"status"`
-	Assignee string `yaml:"assignee"`
+	Status       string            `yaml:"status"`
+	Assignee     lenientStringList `yaml:"assignee"`
+	Author       string            `yaml:"author"`
+	CreationDate string            `yaml:"creation_date"`
+	ApprovedBy   string            `yaml:"approved_by"`
+	ApprovalDate string            `yaml:"approval_date"`
+	DependsOn    lenientStringList `yaml:"depends_on"`
+	Blocks       lenientStringList `yaml:"blocks"`
+	Supersedes   lenientStringList `yaml:"supersedes"`
+	SupersededBy lenientStringList `yaml:"superseded_by"`
+	Labels       lenientStringList `yaml:"labels"`
+	Priority     string            `yaml:"priority"`
+	Milestone    string            `yaml:"milestone"`
+}
+
+// knownFrontmatterKeys lists the keys decoded into frontmatter fields. Any
+// other key is preserved in SpecInfo.Extra.
+var knownFrontmatterKeys = []string{
+	"status",
+	"assignee",
+	"author",
+	"creation_date",
+	"approved_by",
+	"approval_date",
+	"depends_on",
+	"blocks",
+	"supersedes",
+	"superseded_by",
+	"labels",
+	"priority",
+	"milestone",
 }
 
 // Parse reads and parses a spec file, returning a fully populated SpecInfo.
@@ -46,33 +151,44 @@ func Parse(path string) (*SpecInfo, error) {
 		return nil, fmt.Errorf("failed to read file: %w", err)
 	}
 
-	return ParseContent(path, content)
+	info, err := ParseContent(path, content)
+	if err != nil {
+		return nil, err
+	}
+	if filepath.Base(path) != planFilename {
+		info.PlanProgress, info.PlanSectionProgress, err = parsePlanProgress(path)
+		if err != nil {
+			return nil, err
+		}
+	}
+	info.ModTime = latestModTime(path)
+	return info, nil
 }
 
 // ParseContent parses spec content and returns a fully populated SpecInfo.
+// Task progress of a PLAN.md beside a SPEC.md is not read; Parse fills it in.
+// When path is itself a PLAN.md, its tasks are reported as plan progress.
 func ParseContent(path string, content []byte) (*SpecInfo, error) {
 	info := &SpecInfo{
 		Path: path,
 	}
 
 	// Parse with goldmark for frontmatter and title
-	md := goldmark.New(
-		goldmark.WithExtensions(
-			&gmfrontmatter.Extender{},
-		),
-	)
-	ctx := parser.NewContext()
-	reader := text.NewReader(content)
-	doc := md.Parser().Parse(reader, parser.WithContext(ctx))
+	doc, ctx := ParseMarkdown(content)
 
 	// Extract frontmatter
 	var fm frontmatter
+	var extra map[string]any
 	fmData := gmfrontmatter.Get(ctx)
 	if fmData != nil {
 		var decoded frontmatter
 		if err := fmData.Decode(&decoded); err == nil {
 			fm = decoded
 		}
+		var raw map[string]yaml.Node
+		if err := fmData.Decode(&raw); err == nil {
+			extra = extraFrontmatter(raw)
+		}
 	}
 
 	number := extractNumberFromSpecPath(path)
@@ -86,13 +202,88 @@ func ParseContent(path string, content []byte) (*SpecInfo, error) {
 	// Extract title (first H1 heading)
 	info.Name = extractTitle(doc, content)
 
+	info.Sections = extractSections(doc, content)
+	info.Links = extractLinks(doc, content)
+	if filepath.Base(path) == planFilename {
+		info.PlanProgress, info.PlanSectionProgress = extractProgress(doc, content)
+	} else {
+		info.Progress, info.SectionProgress = extractProgress(doc, content)
+	}
+
 	// Status comes from frontmatter only.
-	info.Status = inferStatus(fm.Status)
-	info.Assignee = fm.Assignee
+	info.Status = InferStatus(fm.Status)
+	info.Assignees = fm.Assignee
+	info.Author = fm.Author
+	info.CreationDate = fm.CreationDate
+	info.ApprovedBy = fm.ApprovedBy
+	info.ApprovalDate = fm.ApprovalDate
+	info.DependsOn = fm.DependsOn
+	info.Blocks = fm.Blocks
+	info.Supersedes = fm.Supersedes
+	info.SupersededBy = fm.SupersededBy
+	info.Labels = fm.Labels
+	info.Priority = fm.Priority
+	info.Milestone = fm.Milestone
+	info.Extra = extra
+	info.HasPlan = hasPlanFile(path)
 
 	return info, nil
 }
 
+// ParseMarkdown parses spec or plan content with goldmark and the
+// frontmatter and task list extensions, returning the document and the parser
+// context holding the frontmatter.
+func ParseMarkdown(content []byte) (ast.Node, parser.Context) {
+	md := goldmark.New(
+		goldmark.WithExtensions(
+			&gmfrontmatter.Extender{},
+			extension.TaskList,
+		),
+	)
+	ctx := parser.NewContext()
+	reader := text.NewReader(content)
+	return md.Parser().Parse(reader, parser.WithContext(ctx)), ctx
+}
+
+// extraFrontmatter returns the values of the raw frontmatter entries that are
+// not decoded into dedicated SpecInfo fields. It returns nil when there are
+// none.
+func extraFrontmatter(raw map[string]yaml.Node) map[string]any {
+	for _, key := range knownFrontmatterKeys {
+		delete(raw, key)
+	}
+	if len(raw) == 0 {
+		return nil
+	}
+	extra := make(map[string]any, len(raw))
+	for key, node := range raw {
+		extra[key] = extraValue(&node)
+	}
+	return extra
+}
+
+// hasPlanFile reports whether a PLAN.md file lives in the same directory as
+// the spec file at path.
+func hasPlanFile(path string) bool {
+	if filepath.Base(path) == planFilename {
+		return true
+	}
+	_, err := os.Stat(filepath.Join(filepath.Dir(path), planFilename))
+	return err == nil
+}
+
+// latestModTime returns the later modification time of the file at path and
+// the PLAN.md beside it. Files that cannot be read are ignored.
+func latestModTime(path string) time.Time {
+	var latest time.Time
+	for _, file := range []string{path, filepath.Join(filepath.Dir(path), planFilename)} {
+		if stat, err := os.Stat(file); err == nil && stat.ModTime().After(latest) {
+			latest = stat.ModTime()
+		}
+	}
+	return latest
+}
+
 // ParseAll finds and parses all specs in the given directory, sorted by ascending number.
 func ParseAll(specsDir string) ([]*SpecInfo, error) {
 	paths, err := FindAll(specsDir)
@@ -100,14 +291,12 @@ func ParseAll(specsDir string) ([]*SpecInfo, error) {
 		return nil, err
 	}
 
-	var specs []*SpecInfo
-	for _, p := range paths {
-		info, err := Parse(p)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse %s: %w", p, err)
-		}
+	specs, err := parseSpecs(specsDir, paths)
+	if err != nil {
+		return nil, err
+	}
+	for _, info := range specs {
 		info.Path = relSpecPath(specsDir, info.Path)
-		specs = append(specs, info)
 	}
 
 	sort.Slice(specs, func(i, j int) bool {
@@ -135,27 +324,32 @@ func FindCurrent(specs []*SpecInfo) *SpecInfo {
 	return nil
 }
 
+// resolveFullRef builds a spec's hierarchical reference from its directory
+// chain. Each ancestor directory holding a spec file contributes its number,
+// stopping at the first ancestor without one. Only the filesystem layout is
+// consulted, so no parent spec has to be parsed.
 func resolveFullRef(path string, number int) (string, error) {
 	if number < 0 {
 		return "", nil
 	}
 
-	parentSpecPath, err := findParentSpecPath(path)
-	if err != nil {
-		return "", err
-	}
-	if parentSpecPath != "" {
-		parentInfo, err := Parse(parentSpecPath)
+	ref := strconv.Itoa(number)
+	current := path
+	for {
+		parentSpecPath, err := findParentSpecPath(current)
 		if err != nil {
 			return "", err
 		}
-		if parentInfo.FullRef != "" {
-			return parentInfo.FullRef + "." + strconv.Itoa(number), nil
+		if parentSpecPath == "" {
+			return ref, nil
+		}
+		parentNumber := extractNumberFromSpecPath(parentSpecPath)
+		if parentNumber < 0 {
+			return ref, nil
 		}
-		return strconv.Itoa(number), nil
+		ref = strconv.Itoa(parentNumber) + "." + ref
+		current = parentSpecPath
 	}
-
-	return strconv.Itoa(number), nil
 }
 
 // extractNumberFromSpecPath extracts the local spec number from the path.
@@ -187,13 +381,7 @@ func extractTitle(doc ast.Node, source []byte) string {
 			return ast.WalkContinue, nil
 		}
 		if heading, ok := n.(*ast.Heading); ok && heading.Level == 1 {
-			var buf bytes.Buffer
-			for child := heading.FirstChild(); child != nil; child = child.NextSibling() {
-				if textNode, ok := child.(*ast.Text); ok {
-					buf.Write(textNode.Segment.Value(source))
-				}
-			}
-			title = buf.String()
+			title = headingText(heading, source)
 			return ast.WalkStop, nil
 		}
 		return ast.WalkContinue, nil
@@ -201,8 +389,31 @@ func extractTitle(doc ast.Node, source []byte) string {
 	return title
 }
 
-// inferStatus determines the spec status from frontmatter only.
-func inferStatus(fmStatus string) string {
+// extractSections collects the H2 and H3 headings of a goldmark document.
+func extractSections(doc ast.Node, source []byte) []Section {
+	var sections []Section
+	for n := doc.FirstChild(); n != nil; n = n.NextSibling() {
+		heading, ok := n.(*ast.Heading)
+		if !ok || heading.Level < 2 || heading.Level > 3 {
+			continue
+		}
+		sections = append(sections, Section{
+			Level: heading.Level,
+			Title: headingText(heading, source),
+		})
+	}
+	return sections
+}
+
+// headingText returns the plain text of a heading, including text inside
+// code spans, emphasis, and links.
+func headingText(heading *ast.Heading, source []byte) string {
+	return InlineText(heading, source)
+}
+
+// InferStatus determines the spec status from frontmatter only. Specs
+// without a status are drafts.
+func InferStatus(fmStatus string) string {
 	if fmStatus != "" {
 		return fmStatus
 	}
@@ -241,27 +452,19 @@ func ResolvePath(specsDir, arg string) (string, error) {
 // ResolveRef resolves a top-level or hierarchical spec reference to its spec file.
 // References may contain padded numeric segments, such as 001.002.
 func ResolveRef(specsDir, ref string) (string, error) {
-	fullRef, err := normalizeSpecRef(ref)
-	if err != nil {
+	if _, err := normalizeSpecRef(ref); err != nil {
 		return "", err
 	}
 
-	paths, err := FindAll(specsDir)
+	tree, err := BuildTree(specsDir)
 	if err != nil {
 		return "", err
 	}
-
-	for _, p := range paths {
-		info, err := Parse(p)
-		if err != nil {
-			continue
-		}
-		if info.FullRef == fullRef {
-			return p, nil
-		}
+	node, err := tree.Lookup(ref)
+	if err != nil {
+		return "", err
 	}
-
-	return "", fmt.Errorf("spec not found: %s", ref)
+	return node.FilePath, nil
 }
 
 // FindSpecsInScope returns parsed specs that belong directly under the requested scope.
@@ -279,34 +482,16 @@ func FindSpecsInScopeDepth(specsDir, parentPath string, depth int) ([]*SpecInfo,
 		return nil, fmt.Errorf("parent spec must be a SPEC.md or PLAN.md file: %s", parentPath)
 	}
 
-	paths, err := FindAll(specsDir)
+	tree, err := BuildTree(specsDir)
 	if err != nil {
 		return nil, err
 	}
 
-	// Filter paths by scope membership and depth. d < 1 means the spec is
-	// either the scope root itself or not a descendant at all.
-	var scopedPaths []string
-	for _, path := range paths {
-		d := specDepth(path, specsDir, parentPath)
-		if d < 1 {
-			continue
-		}
-		if depth > 0 && d > depth {
-			continue
-		}
-		scopedPaths = append(scopedPaths, path)
-	}
-
-	var specs []*SpecInfo
-	for _, path := range scopedPaths {
-		info, err := Parse(path)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse %s: %w", path, err)
-		}
-		info.Path = relSpecPath(specsDir, info.Path)
-		specs = append(specs, info)
+	nodes, err := tree.ScopeDepth(parentPath, depth)
+	if err != nil {
+		return nil, err
 	}
+	specs := specInfos(nodes)
 
 	// Sort by FullRef, not Number. For nested specs, the local Number field
 	// (from the directory prefix like "001-") doesn't reflect hierarchical
@@ -336,55 +521,6 @@ func compareFullRefs(a, b string) bool {
 	return len(aParts) < len(bParts)
 }
 
-// specDepth computes how many directory levels a spec is from the scope root.
-// Uses filepath.Rel to count directory separators between root and the spec's
-// parent directory. rel == "." means root == specDir (the scope root itself,
-// depth 0). rel starting with ".." means the spec is outside the scope tree.
-// Returns -1 for non-descendants.
-func specDepth(specPath, specsDir, parentPath string) int {
-	if !IsSpecFilePath(specPath) {
-		return -1
-	}
-
-	specDir := filepath.Dir(specPath)
-
-	var rootDir string
-	if parentPath != "" {
-		rootDir = filepath.Dir(parentPath)
-	} else {
-		rootDir = specsDir
-	}
-
-	rel, err := filepath.Rel(rootDir, specDir)
-	if err != nil {
-		return -1
-	}
-
-	if rel == "." {
-		return 0
-	}
-
-	parts := strings.Split(rel, string(filepath.Separator))
-	if parts[0] == ".." {
-		return -1
-	}
-
-	return len(parts)
-}
-
-// IsTopLevelSpecPath reports whether a spec path belongs directly under specsDir.
-func IsTopLevelSpecPath(specPath, specsDir string) bool {
-	return IsSpecFilePath(specPath) &&
-		filepath.Dir(filepath.Dir(specPath)) == specsDir
-}
-
-// IsImmediateChildSpecPath reports whether specPath is a direct child of parentPath.
-// This is the path shape used for nested specs created under a parent spec directory.
-func IsImmediateChildSpecPath(specPath, parentPath string) bool {
-	return IsSpecFilePath(specPath) &&
-		filepath.Dir(filepath.Dir(specPath)) == filepath.Dir(parentPath)
-}
-
 // collectSpecPaths walks the specs tree and records every discoverable spec file.
 func collectSpecPaths(dir string, paths *[]string) error {
 	entries, err := os.ReadDir(dir)
@@ -469,6 +605,12 @@ func findParentSpecPath(path string) (string, error) {
 	return "", nil
 }
 
+// NormalizeRef canonicalizes a spec reference such as 001.002 to the FullRef
+// form 1.2, returning an error for malformed references.
+func NormalizeRef(ref string) (string, error) {
+	return normalizeSpecRef(ref)
+}
+
 // normalizeSpecRef canonicalizes a user-provided reference so lookup can match
 // against parsed FullRef values. It trims whitespace and removes leading zeros
 // from each segment, so values like 001.002 compare as 1.2.
@@ -72,35 +72,227 @@ func TestStatusInference(t *testing.T) {
 
 func TestParseContent_Assignee(t *testing.T) {
 	tests := []struct {
-		name         string
-		frontmatter  string
-		wantAssignee string
+		name          string
+		frontmatter   string
+		wantAssignees []string
 	}{
 		{
-			name:         "assigned",
-			frontmatter:  "status: approved\nassignee: Alice Example",
-			wantAssignee: "Alice Example",
+			name:          "single assignee",
+			frontmatter:   "status: in-progress\nassignee: Alice Example",
+			wantAssignees: []string{"Alice Example"},
 		},
 		{
-			name:         "unassigned",
-			frontmatter:  "status: approved",
-			wantAssignee: "",
+			name:          "multiple assignees",
+			frontmatter:   "status: in-progress\nassignee: [Alice Example, \" Bob Builder \"]",
+			wantAssignees: []string{"Alice Example", "Bob Builder"},
+		},
+		{
+			name:          "block list of assignees",
+			frontmatter:   "status: in-progress\nassignee:\n  - Alice Example\n  - Bob Builder",
+			wantAssignees: []string{"Alice Example", "Bob Builder"},
+		},
+		{
+			name:          "unassigned",
+			frontmatter:   "status: draft",
+			wantAssignees: nil,
+		},
+		{
+			name:          "malformed assignee keeps other fields",
+			frontmatter:   "status: draft\nassignee: {name: Alice}",
+			wantAssignees: nil,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			info, err := ParseContent("001-test.md", buildSpec(tt.frontmatter, "Test", "Description."))
+			info, err := ParseContent("specs/001-test/SPEC.md", buildSpec(tt.frontmatter, "Test", ""))
 			if err != nil {
 				t.Fatalf("unexpected error: %v", err)
 			}
-			if info.Assignee != tt.wantAssignee {
-				t.Errorf("expected assignee %q, got %q", tt.wantAssignee, info.Assignee)
+			if strings.Join(info.Assignees, "|") != strings.Join(tt.wantAssignees, "|") {
+				t.Errorf("expected assignees %q, got %q", tt.wantAssignees, info.Assignees)
+			}
+			if info.Status == "" {
+				t.Errorf("expected status to be parsed")
+			}
+			if got, want := info.AssigneeList(), strings.Join(tt.wantAssignees, ", "); got != want {
+				t.Errorf("expected assignee list %q, got %q", want, got)
 			}
 		})
 	}
 }
 
+func TestParseContent_Frontmatter(t *testing.T) {
+	content := buildSpec(`status: approved
+author: Alice Example
+creation_date: 2026-01-02
+approved_by: Bob Builder
+approval_date: 2026-01-03
+area: cli
+reviewers:
+  - Carol`, "Test", "Description.")
+
+	info, err := ParseContent("001-test.md", content)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if info.Author != "Alice Example" {
+		t.Errorf("expected author %q, got %q", "Alice Example", info.Author)
+	}
+	if info.CreationDate != "2026-01-02" {
+		t.Errorf("expected creation date %q, got %q", "2026-01-02", info.CreationDate)
+	}
+	if info.ApprovedBy != "Bob Builder" {
+		t.Errorf("expected approved_by %q, got %q", "Bob Builder", info.ApprovedBy)
+	}
+	if info.ApprovalDate != "2026-01-03" {
+		t.Errorf("expected approval date %q, got %q", "2026-01-03", info.ApprovalDate)
+	}
+	if len(info.Extra) != 2 {
+		t.Fatalf("expected only unknown keys in Extra, got %v", info.Extra)
+	}
+	if info.Extra["area"] != "cli" {
+		t.Errorf("expected extra area %q, got %v", "cli", info.Extra["area"])
+	}
+	if reviewers, ok := info.Extra["reviewers"].([]any); !ok || len(reviewers) != 1 || reviewers[0] != "Carol" {
+		t.Errorf("expected extra reviewers [Carol], got %v", info.Extra["reviewers"])
+	}
+}
+
+func TestParseContent_Dependencies(t *testing.T) {
+	tests := []struct {
+		name          string
+		frontmatter   string
+		wantDependsOn []string
+		wantBlocks    []string
+	}{
+		{"lists keep ref text", "depends_on: [4.10, 001]\nblocks: [2]", []string{"4.10", "001"}, []string{"2"}},
+		{"scalar", "depends_on: 4.2", []string{"4.2"}, nil},
+		{"malformed is ignored", "status: approved\ndepends_on:\n  ref: 2", nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			info, err := ParseContent("001-test.md", buildSpec(tt.frontmatter, "Test", ""))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if strings.Join(info.DependsOn, ",") != strings.Join(tt.wantDependsOn, ",") {
+				t.Errorf("expected depends_on %v, got %v", tt.wantDependsOn, info.DependsOn)
+			}
+			if strings.Join(info.Blocks, ",") != strings.Join(tt.wantBlocks, ",") {
+				t.Errorf("expected blocks %v, got %v", tt.wantBlocks, info.Blocks)
+			}
+		})
+	}
+
+	// A malformed dependency field must not discard the rest of the frontmatter.
+	info, err := ParseContent("001-test.md", buildSpec("status: approved\ndepends_on:\n  ref: 2", "Test", ""))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if info.Status != "approved" {
+		t.Errorf("expected status to survive malformed depends_on, got %q", info.Status)
+	}
+}
+
+func TestParseContent_Labels(t *testing.T) {
+	info, err := ParseContent("001-test.md", buildSpec("status: draft\nlabels: [cli, validation]", "Test", ""))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Join(info.Labels, ",") != "cli,validation" {
+		t.Errorf("expected labels cli,validation, got %v", info.Labels)
+	}
+	if _, ok := info.Extra["labels"]; ok {
+		t.Errorf("expected labels to be a known field, got extra %v", info.Extra)
+	}
+
+	info, err = ParseContent("001-test.md", buildSpec("status: draft\nlabels: docs", "Test", ""))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Join(info.Labels, ",") != "docs" {
+		t.Errorf("expected a scalar label to become a list, got %v", info.Labels)
+	}
+}
+
+func TestParseContent_PriorityAndMilestone(t *testing.T) {
+	info, err := ParseContent("001-test.md", buildSpec("status: draft\npriority: high\nmilestone: 1.0", "Test", ""))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if info.Priority != "high" {
+		t.Errorf("expected priority high, got %q", info.Priority)
+	}
+	if info.Milestone != "1.0" {
+		t.Errorf("expected milestone 1.0, got %q", info.Milestone)
+	}
+	if info.Extra != nil {
+		t.Errorf("expected priority and milestone to be known fields, got extra %v", info.Extra)
+	}
+}
+
+func TestParseContent_NoExtraFrontmatter(t *testing.T) {
+	info, err := ParseContent("001-test.md", buildSpec("status: draft", "Test", ""))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if info.Extra != nil {
+		t.Errorf("expected nil Extra, got %v", info.Extra)
+	}
+}
+
+func TestParseContent_Sections(t *testing.T) {
+	body := "## Goals\n\n### Stretch `status` Goals\n\n#### Too Deep\n\n## Design *Decisions*\n"
+	info, err := ParseContent("001-test.md", buildSpec("status: draft", "Test", body))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []Section{
+		{Level: 2, Title: "Goals"},
+		{Level: 3, Title: "Stretch status Goals"},
+		{Level: 2, Title: "Design Decisions"},
+	}
+	if len(info.Sections) != len(want) {
+		t.Fatalf("expected %d sections, got %v", len(want), info.Sections)
+	}
+	for i := range want {
+		if info.Sections[i] != want[i] {
+			t.Errorf("section %d: expected %+v, got %+v", i, want[i], info.Sections[i])
+		}
+	}
+}
+
+func TestParse_HasPlan(t *testing.T) {
+	dir := t.TempDir()
+	withPlan := filepath.Join(dir, "001-with-plan", specFilename)
+	withoutPlan := filepath.Join(dir, "002-without-plan", specFilename)
+	standalonePlan := filepath.Join(dir, "003-standalone", planFilename)
+	for _, path := range []string{withPlan, withoutPlan, standalonePlan} {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, buildSpec("status: draft", "Test", ""), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.WriteFile(filepath.Join(filepath.Dir(withPlan), planFilename), []byte("# Plan\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]bool{withPlan: true, withoutPlan: false, standalonePlan: true}
+	for path, want := range tests {
+		info, err := Parse(path)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if info.HasPlan != want {
+			t.Errorf("%s: expected HasPlan %t, got %t", path, want, info.HasPlan)
+		}
+	}
+}
+
 // ---------- FindAll tests ----------
 
 func TestFindAll_MatchesOnlySpecFiles(t *testing.T) {
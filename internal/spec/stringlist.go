@@ -0,0 +1,50 @@
+package spec
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ParseStringList decodes a frontmatter value written either as a single
+// scalar or as a sequence of scalars. Scalars keep their source text, so refs
+// such as 4.10 are not reinterpreted as numbers. Empty values are dropped.
+func ParseStringList(node *yaml.Node) ([]string, error) {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		if node.Tag == "!!null" {
+			return nil, nil
+		}
+		if value := strings.TrimSpace(node.Value); value != "" {
+			return []string{value}, nil
+		}
+		return nil, nil
+	case yaml.SequenceNode:
+		var values []string
+		for _, item := range node.Content {
+			if item.Kind != yaml.ScalarNode || item.Tag == "!!null" {
+				return nil, fmt.Errorf("list items must be plain values")
+			}
+			if value := strings.TrimSpace(item.Value); value != "" {
+				values = append(values, value)
+			}
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("must be a value or a list of values")
+	}
+}
+
+// lenientStringList decodes like ParseStringList but ignores malformed
+// values instead of failing, so one bad field does not discard the rest of
+// the frontmatter. Validation reports malformed values separately.
+type lenientStringList []string
+
+func (l *lenientStringList) UnmarshalYAML(node *yaml.Node) error {
+	values, err := ParseStringList(node)
+	if err == nil {
+		*l = values
+	}
+	return nil
+}
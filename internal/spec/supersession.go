@@ -0,0 +1,38 @@
+package spec
+
+// Superseders returns the specs that replace node: the refs in its
+// superseded_by list plus every spec whose supersedes list names it. Refs
+// that do not resolve to a spec are ignored; validation reports them.
+func (t *Tree) Superseders(node *Node) []*Node {
+	var superseders []*Node
+	seen := make(map[*Node]bool)
+	add := func(other *Node) {
+		if other != node && !seen[other] {
+			seen[other] = true
+			superseders = append(superseders, other)
+		}
+	}
+
+	for _, ref := range node.Spec.SupersededBy {
+		if other, err := t.Lookup(ref); err == nil {
+			add(other)
+		}
+	}
+
+	for _, other := range t.Nodes() {
+		for _, ref := range other.Spec.Supersedes {
+			if replaced, err := t.Lookup(ref); err == nil && replaced == node {
+				add(other)
+				break
+			}
+		}
+	}
+
+	return superseders
+}
+
+// IsSuperseded reports whether another spec in the tree replaces node, as
+// recorded on either side of the relation.
+func (t *Tree) IsSuperseded(node *Node) bool {
+	return len(t.Superseders(node)) > 0
+}
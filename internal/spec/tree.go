@@ -0,0 +1,239 @@
+package spec
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// Tree is an in-memory view of the spec hierarchy built from a single walk of
+// the specs directory. Commands that need to answer several questions about
+// the tree should build it once and query it instead of re-walking the
+// filesystem.
+type Tree struct {
+	SpecsDir string
+	// Roots holds the top-level specs, sorted by FullRef.
+	Roots []*Node
+
+	nodes  []*Node
+	byRef  map[string]*Node
+	byPath map[string]*Node
+	byDir  map[string]*Node
+	bySpec map[*SpecInfo]*Node
+}
+
+// Node is a spec within a Tree.
+type Node struct {
+	// Spec holds the parsed metadata. Spec.Path is relative to the project
+	// root, matching ParseAll.
+	Spec *SpecInfo
+	// FilePath is the absolute path of the spec file on disk.
+	FilePath string
+	Parent   *Node
+	// Children holds the immediate child specs, sorted by FullRef.
+	Children []*Node
+}
+
+// BuildTree walks specsDir once and returns the parsed spec hierarchy.
+func BuildTree(specsDir string) (*Tree, error) {
+	absSpecsDir, err := filepath.Abs(specsDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve specs directory: %w", err)
+	}
+
+	paths, err := FindAll(absSpecsDir)
+	if err != nil {
+		return nil, err
+	}
+
+	specs, err := parseSpecs(absSpecsDir, paths)
+	if err != nil {
+		return nil, err
+	}
+
+	tree := &Tree{
+		SpecsDir: absSpecsDir,
+		byRef:    make(map[string]*Node, len(specs)),
+		byPath:   make(map[string]*Node, len(specs)),
+		byDir:    make(map[string]*Node, len(specs)),
+		bySpec:   make(map[*SpecInfo]*Node, len(specs)),
+	}
+	for i, info := range specs {
+		info.Path = relSpecPath(absSpecsDir, paths[i])
+		node := &Node{Spec: info, FilePath: paths[i]}
+		tree.nodes = append(tree.nodes, node)
+		tree.byPath[node.FilePath] = node
+		tree.byDir[filepath.Dir(node.FilePath)] = node
+		tree.bySpec[info] = node
+		// Paths are sorted, so the first spec claiming a ref wins, matching
+		// the historical ResolveRef behavior for duplicate refs.
+		if info.FullRef != "" {
+			if _, exists := tree.byRef[info.FullRef]; !exists {
+				tree.byRef[info.FullRef] = node
+			}
+		}
+	}
+
+	// A spec's parent is the spec in the directory directly above its own,
+	// mirroring how FullRef is derived.
+	for _, node := range tree.nodes {
+		parentDir := filepath.Dir(filepath.Dir(node.FilePath))
+		if parent, ok := tree.byDir[parentDir]; ok {
+			node.Parent = parent
+			parent.Children = append(parent.Children, node)
+		} else {
+			tree.Roots = append(tree.Roots, node)
+		}
+	}
+
+	sortNodes(tree.Roots)
+	for _, node := range tree.nodes {
+		sortNodes(node.Children)
+	}
+
+	return tree, nil
+}
+
+// Nodes returns every spec in the tree in depth-first order.
+func (t *Tree) Nodes() []*Node {
+	var nodes []*Node
+	for _, root := range t.Roots {
+		nodes = append(nodes, root)
+		nodes = append(nodes, root.Descendants()...)
+	}
+	return nodes
+}
+
+// Lookup returns the spec with the given reference. References may contain
+// padded numeric segments, such as 001.002.
+func (t *Tree) Lookup(ref string) (*Node, error) {
+	fullRef, err := normalizeSpecRef(ref)
+	if err != nil {
+		return nil, err
+	}
+	node, ok := t.byRef[fullRef]
+	if !ok {
+		return nil, fmt.Errorf("spec not found: %s", ref)
+	}
+	return node, nil
+}
+
+// NodeForPath returns the spec owning the given SPEC.md or PLAN.md path, or
+// nil when the path is not part of the tree. A PLAN.md beside a SPEC.md
+// resolves to the spec that owns the directory.
+func (t *Tree) NodeForPath(path string) *Node {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil
+	}
+	if node, ok := t.byPath[absPath]; ok {
+		return node
+	}
+	if IsSpecFilePath(absPath) {
+		return t.byDir[filepath.Dir(absPath)]
+	}
+	return nil
+}
+
+// Resolve resolves a spec reference or existing spec file path to its node,
+// accepting the same arguments as ResolvePath.
+func (t *Tree) Resolve(arg string) (*Node, error) {
+	if _, err := os.Stat(arg); err == nil {
+		if !IsSpecFilePath(arg) {
+			return nil, fmt.Errorf("spec paths must point to a SPEC.md or PLAN.md file: %s", arg)
+		}
+		node := t.NodeForPath(arg)
+		if node == nil {
+			return nil, fmt.Errorf("spec not found: %s", arg)
+		}
+		return node, nil
+	}
+
+	return t.Lookup(arg)
+}
+
+// Scope returns the immediate children of the spec at parentPath, or the
+// top-level specs when parentPath is empty.
+func (t *Tree) Scope(parentPath string) ([]*Node, error) {
+	if parentPath == "" {
+		return t.Roots, nil
+	}
+	if !IsSpecFilePath(parentPath) {
+		return nil, fmt.Errorf("parent spec must be a SPEC.md or PLAN.md file: %s", parentPath)
+	}
+	parent := t.NodeForPath(parentPath)
+	if parent == nil {
+		return nil, fmt.Errorf("parent spec not found: %s", parentPath)
+	}
+	return parent.Children, nil
+}
+
+// ScopeDepth returns the specs within depth levels below the scope root,
+// sorted by FullRef. depth 1 returns the same specs as Scope; depth <= 0 is
+// treated as unlimited.
+func (t *Tree) ScopeDepth(parentPath string, depth int) ([]*Node, error) {
+	scope, err := t.Scope(parentPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []*Node
+	var visit func(level []*Node, d int)
+	visit = func(level []*Node, d int) {
+		for _, node := range level {
+			nodes = append(nodes, node)
+			if depth <= 0 || d < depth {
+				visit(node.Children, d+1)
+			}
+		}
+	}
+	visit(scope, 1)
+
+	return nodes, nil
+}
+
+// Ancestors returns the node's ancestors, nearest first.
+func (n *Node) Ancestors() []*Node {
+	var ancestors []*Node
+	for parent := n.Parent; parent != nil; parent = parent.Parent {
+		ancestors = append(ancestors, parent)
+	}
+	return ancestors
+}
+
+// Descendants returns every spec below the node in depth-first order.
+func (n *Node) Descendants() []*Node {
+	var descendants []*Node
+	for _, child := range n.Children {
+		descendants = append(descendants, child)
+		descendants = append(descendants, child.Descendants()...)
+	}
+	return descendants
+}
+
+// Depth returns the number of ancestors above the node; top-level specs have
+// depth 0.
+func (n *Node) Depth() int {
+	depth := 0
+	for parent := n.Parent; parent != nil; parent = parent.Parent {
+		depth++
+	}
+	return depth
+}
+
+// specInfos unwraps the SpecInfo of each node.
+func specInfos(nodes []*Node) []*SpecInfo {
+	specs := make([]*SpecInfo, 0, len(nodes))
+	for _, node := range nodes {
+		specs = append(specs, node.Spec)
+	}
+	return specs
+}
+
+// sortNodes orders sibling nodes by FullRef.
+func sortNodes(nodes []*Node) {
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return compareFullRefs(nodes[i].Spec.FullRef, nodes[j].Spec.FullRef)
+	})
+}
@@ -0,0 +1,241 @@
+package spec
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// setupTreeTest creates a specs directory with a small hierarchy:
+//
+//	1
+//	1.1 (PLAN.md only)
+//	1.1.1
+//	1.2
+//	2
+func setupTreeTest(t *testing.T) string {
+	t.Helper()
+	specsDir := filepath.Join(t.TempDir(), "specs")
+	files := []string{
+		"001-parent/SPEC.md",
+		"001-parent/001-plan-child/PLAN.md",
+		"001-parent/001-plan-child/001-grandchild/SPEC.md",
+		"001-parent/002-child/SPEC.md",
+		"002-other/SPEC.md",
+	}
+	for _, name := range files {
+		path := filepath.Join(specsDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatalf("failed to create directory for %s: %v", name, err)
+		}
+		if err := os.WriteFile(path, buildSpec("status: draft", filepath.Base(filepath.Dir(name)), ""), 0o644); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+	return specsDir
+}
+
+func nodeRefs(nodes []*Node) []string {
+	refs := make([]string, 0, len(nodes))
+	for _, node := range nodes {
+		refs = append(refs, node.Spec.FullRef)
+	}
+	return refs
+}
+
+func assertRefs(t *testing.T, label string, got []*Node, want ...string) {
+	t.Helper()
+	refs := nodeRefs(got)
+	if len(refs) != len(want) {
+		t.Fatalf("%s: expected refs %v, got %v", label, want, refs)
+	}
+	for i := range want {
+		if refs[i] != want[i] {
+			t.Fatalf("%s: expected refs %v, got %v", label, want, refs)
+		}
+	}
+}
+
+func TestBuildTree_Hierarchy(t *testing.T) {
+	tree, err := BuildTree(setupTreeTest(t))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assertRefs(t, "roots", tree.Roots, "1", "2")
+	assertRefs(t, "nodes", tree.Nodes(), "1", "1.1", "1.1.1", "1.2", "2")
+
+	parent, err := tree.Lookup("001")
+	if err != nil {
+		t.Fatalf("unexpected lookup error: %v", err)
+	}
+	assertRefs(t, "children", parent.Children, "1.1", "1.2")
+	assertRefs(t, "descendants", parent.Descendants(), "1.1", "1.1.1", "1.2")
+	if parent.Parent != nil || parent.Depth() != 0 {
+		t.Errorf("expected top-level parent, got parent %v depth %d", parent.Parent, parent.Depth())
+	}
+
+	grandchild, err := tree.Lookup("1.1.1")
+	if err != nil {
+		t.Fatalf("unexpected lookup error: %v", err)
+	}
+	assertRefs(t, "ancestors", grandchild.Ancestors(), "1.1", "1")
+	if grandchild.Depth() != 2 {
+		t.Errorf("expected depth 2, got %d", grandchild.Depth())
+	}
+	if grandchild.Spec.Path != filepath.Join("specs", "001-parent", "001-plan-child", "001-grandchild", "SPEC.md") {
+		t.Errorf("expected project-relative path, got %q", grandchild.Spec.Path)
+	}
+	if !filepath.IsAbs(grandchild.FilePath) {
+		t.Errorf("expected absolute file path, got %q", grandchild.FilePath)
+	}
+}
+
+func TestTree_LookupErrors(t *testing.T) {
+	tree, err := BuildTree(setupTreeTest(t))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := tree.Lookup("9"); err == nil || err.Error() != "spec not found: 9" {
+		t.Errorf("expected not found error, got %v", err)
+	}
+	if _, err := tree.Lookup("1..2"); err == nil {
+		t.Error("expected invalid reference error")
+	}
+}
+
+func TestTree_NodeForPath(t *testing.T) {
+	specsDir := setupTreeTest(t)
+	tree, err := BuildTree(specsDir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	node := tree.NodeForPath(filepath.Join(specsDir, "001-parent", "002-child", "SPEC.md"))
+	if node == nil || node.Spec.FullRef != "1.2" {
+		t.Fatalf("expected node 1.2, got %v", node)
+	}
+
+	// A PLAN.md beside a SPEC.md belongs to the same spec.
+	node = tree.NodeForPath(filepath.Join(specsDir, "001-parent", "PLAN.md"))
+	if node == nil || node.Spec.FullRef != "1" {
+		t.Fatalf("expected sibling plan to resolve to spec 1, got %v", node)
+	}
+
+	if node := tree.NodeForPath(filepath.Join(specsDir, "README.md")); node != nil {
+		t.Fatalf("expected no node for non-spec file, got %v", node.Spec.FullRef)
+	}
+}
+
+func TestTree_ScopeDepth(t *testing.T) {
+	specsDir := setupTreeTest(t)
+	tree, err := BuildTree(specsDir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	parentPath := filepath.Join(specsDir, "001-parent", "SPEC.md")
+
+	tests := []struct {
+		name   string
+		parent string
+		depth  int
+		want   []string
+	}{
+		{"top-level depth 1", "", 1, []string{"1", "2"}},
+		{"top-level depth 2", "", 2, []string{"1", "1.1", "1.2", "2"}},
+		{"top-level unlimited", "", 0, []string{"1", "1.1", "1.1.1", "1.2", "2"}},
+		{"parent depth 1", parentPath, 1, []string{"1.1", "1.2"}},
+		{"parent unlimited", parentPath, 0, []string{"1.1", "1.1.1", "1.2"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodes, err := tree.ScopeDepth(tt.parent, tt.depth)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			assertRefs(t, tt.name, nodes, tt.want...)
+		})
+	}
+
+	if _, err := tree.Scope(filepath.Join(specsDir, "README.md")); err == nil {
+		t.Error("expected error for non-spec parent path")
+	}
+}
+
+func TestTree_Dependencies(t *testing.T) {
+	specsDir := filepath.Join(t.TempDir(), "specs")
+	files := map[string]string{
+		"001-done/SPEC.md":    "status: completed",
+		"002-feature/SPEC.md": "status: approved\ndepends_on: [1, 9]",
+		"003-blocker/SPEC.md": "status: draft\nblocks: 002",
+	}
+	for name, frontmatter := range files {
+		path := filepath.Join(specsDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, buildSpec(frontmatter, "Spec", ""), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tree, err := BuildTree(specsDir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	feature, _ := tree.Lookup("2")
+	deps, missing := tree.Dependencies(feature)
+	assertRefs(t, "dependencies", deps, "1", "3")
+	if len(missing) != 1 || missing[0] != "9" {
+		t.Errorf("expected missing ref 9, got %v", missing)
+	}
+	if tree.IsReady(feature) {
+		t.Error("expected spec with unresolved and incomplete dependencies not to be ready")
+	}
+
+	done, _ := tree.Lookup("1")
+	if tree.IsReady(done) {
+		t.Error("expected completed spec not to be ready")
+	}
+	if tree.NodeForSpec(done.Spec) != done {
+		t.Error("expected NodeForSpec to return the owning node")
+	}
+}
+
+func TestTree_Superseders(t *testing.T) {
+	specsDir := filepath.Join(t.TempDir(), "specs")
+	files := map[string]string{
+		"001-old/SPEC.md":     "status: approved\nsuperseded_by: 3",
+		"002-legacy/SPEC.md":  "status: approved",
+		"003-new/SPEC.md":     "status: approved\nsupersedes: 2",
+		"004-current/SPEC.md": "status: approved",
+	}
+	for name, frontmatter := range files {
+		path := filepath.Join(specsDir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, buildSpec(frontmatter, "Spec", ""), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tree, err := BuildTree(specsDir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, ref := range []string{"1", "2"} {
+		node := mustLookup(t, tree, ref)
+		assertRefs(t, "superseders of "+ref, tree.Superseders(node), "3")
+		if tree.IsReady(node) {
+			t.Errorf("expected superseded spec %s not to be ready", ref)
+		}
+	}
+	if tree.IsSuperseded(mustLookup(t, tree, "3")) {
+		t.Error("expected superseding spec not to be superseded")
+	}
+	if !tree.IsReady(mustLookup(t, tree, "4")) {
+		t.Error("expected unrelated approved spec to be ready")
+	}
+}
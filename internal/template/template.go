@@ -3,20 +3,63 @@ package template
 import (
 	"bytes"
 	"fmt"
+	"strings"
 	tpl "text/template"
+	"unicode/utf8"
 )
 
-// RenderTemplate renders a Go template with the given data and returns the result as a string.
-func RenderTemplate(templateStr string, data any) (string, error) {
-	t, err := tpl.New("").Parse(templateStr)
+// Funcs are the helper functions available to every template. Functions
+// taking a list or string last work in pipelines, as in
+// {{.Labels | join ", "}} or {{.Ref | pad 6}}.
+var Funcs = tpl.FuncMap{
+	// join joins a list of strings with a separator.
+	"join":  func(sep string, items []string) string { return strings.Join(items, sep) },
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"trim":  strings.TrimSpace,
+	// pad left-aligns s in a field of width characters.
+	"pad": func(width int, s string) string { return pad(width, s, false) },
+	// padLeft right-aligns s in a field of width characters.
+	"padLeft": func(width int, s string) string { return pad(width, s, true) },
+}
+
+// pad adds spaces to s up to width characters, before s when right is set.
+func pad(width int, s string, right bool) string {
+	n := width - utf8.RuneCountInString(s)
+	if n <= 0 {
+		return s
+	}
+	if right {
+		return strings.Repeat(" ", n) + s
+	}
+	return s + strings.Repeat(" ", n)
+}
+
+// Parse parses a Go template with Funcs and the extra functions, which take
+// precedence over Funcs.
+func Parse(templateStr string, extra tpl.FuncMap) (*tpl.Template, error) {
+	t, err := tpl.New("").Funcs(Funcs).Funcs(extra).Parse(templateStr)
 	if err != nil {
-		return "", fmt.Errorf("failed to parse template: %w", err)
+		return nil, fmt.Errorf("failed to parse template: %w", err)
 	}
+	return t, nil
+}
 
+// Execute renders a parsed template with the given data and returns the
+// result as a string.
+func Execute(t *tpl.Template, data any) (string, error) {
 	var buf bytes.Buffer
 	if err := t.Execute(&buf, data); err != nil {
 		return "", fmt.Errorf("failed to execute template: %w", err)
 	}
-
 	return buf.String(), nil
 }
+
+// RenderTemplate renders a Go template with the given data and returns the result as a string.
+func RenderTemplate(templateStr string, data any) (string, error) {
+	t, err := Parse(templateStr, nil)
+	if err != nil {
+		return "", err
+	}
+	return Execute(t, data)
+}
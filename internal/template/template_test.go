@@ -110,3 +110,28 @@ func TestRenderTemplate(t *testing.T) {
 		})
 	}
 }
+
+func TestRenderTemplate_Funcs(t *testing.T) {
+	data := map[string]any{"Ref": "4.1", "Labels": []string{"cli", "docs"}, "Name": " List "}
+	got, err := RenderTemplate(`{{.Ref | pad 5}}|{{.Ref | padLeft 5}}|{{.Labels | join ", "}}|{{upper "x"}}{{lower "Y"}}|{{trim .Name}}`, data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "4.1  |  4.1|cli, docs|Xy|List"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestParse_ExtraFuncs(t *testing.T) {
+	tmpl, err := Parse(`{{shout .}}`, map[string]any{"shout": func(s string) string { return s + "!" }})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got, err := Execute(tmpl, "hi")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got != "hi!" {
+		t.Errorf("expected %q, got %q", "hi!", got)
+	}
+}
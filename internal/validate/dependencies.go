@@ -0,0 +1,234 @@
+package validate
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/specture-system/specture/internal/rules"
+	specpkg "github.com/specture-system/specture/internal/spec"
+)
+
+// dependencyFields are the frontmatter fields that hold lists of spec refs
+// describing ordering between specs.
+var dependencyFields = []string{"depends_on", "blocks"}
+
+// refListFields are all frontmatter fields that hold lists of spec refs.
+var refListFields = append(slices.Clone(dependencyFields), supersessionFields...)
+
+// validateRefListFields checks that ref list fields are well-formed. Whether
+// the refs exist is checked across specs in ValidateSpecs.
+func validateRefListFields(spec *Spec, result *ValidationResult) {
+	if spec.Frontmatter == nil {
+		return
+	}
+
+	for _, field := range refListFields {
+		node, ok := spec.Frontmatter.Fields[field]
+		if !ok {
+			continue
+		}
+		refs, err := specpkg.ParseStringList(&node)
+		if err != nil {
+			result.Errors = append(result.Errors, ValidationError{
+				Field:   field,
+				Message: "must be a spec reference or a list of spec references",
+				Rule:    rules.RefFields,
+			})
+			continue
+		}
+		for _, ref := range refs {
+			if _, err := specpkg.NormalizeRef(ref); err != nil {
+				result.Errors = append(result.Errors, ValidationError{
+					Field:   field,
+					Message: fmt.Sprintf("invalid spec ref %q", ref),
+					Rule:    rules.RefFields,
+				})
+			}
+		}
+	}
+}
+
+// frontmatterRefs returns the well-formed, normalized refs listed in a
+// frontmatter field. Malformed values are skipped; ValidateSpec reports them.
+func frontmatterRefs(spec *Spec, field string) []string {
+	if spec.Frontmatter == nil {
+		return nil
+	}
+	node, ok := spec.Frontmatter.Fields[field]
+	if !ok {
+		return nil
+	}
+	values, err := specpkg.ParseStringList(&node)
+	if err != nil {
+		return nil
+	}
+
+	var refs []string
+	for _, value := range values {
+		if ref, err := specpkg.NormalizeRef(value); err == nil {
+			refs = append(refs, ref)
+		}
+	}
+	return refs
+}
+
+// validateDependencies rejects dependency refs that do not name a spec in the
+// set and dependency cycles. depends_on adds an edge from a spec to each ref;
+// blocks adds an edge from each ref back to the spec.
+func validateDependencies(specs []*Spec, results []*ValidationResult, refToIdx map[string][]int) {
+	edges := make(map[string][]string)
+	for i, spec := range specs {
+		fullRef := specFullRef(spec)
+		for _, field := range dependencyFields {
+			for _, ref := range frontmatterRefs(spec, field) {
+				if !knownRef(ref, field, results[i], refToIdx) {
+					continue
+				}
+				if fullRef == "" {
+					continue
+				}
+				if field == "depends_on" {
+					edges[fullRef] = appendUnique(edges[fullRef], ref)
+				} else {
+					edges[ref] = appendUnique(edges[ref], fullRef)
+				}
+			}
+		}
+	}
+
+	for _, cycle := range findDependencyCycles(edges) {
+		message := fmt.Sprintf("dependency cycle: %s", strings.Join(cycle, " → "))
+		for _, ref := range cycle[:len(cycle)-1] {
+			for _, idx := range refToIdx[ref] {
+				results[idx].Errors = append(results[idx].Errors, ValidationError{
+					Field:   "depends_on",
+					Message: message,
+					Rule:    rules.DependencyCycle,
+				})
+			}
+		}
+	}
+}
+
+// findDependencyCycles returns one cycle per strongly connected component of
+// the dependency graph that contains a cycle. Each cycle starts and ends with
+// the component's lowest ref, e.g. [1 2 1].
+func findDependencyCycles(edges map[string][]string) [][]string {
+	var nodes []string
+	for from, tos := range edges {
+		nodes = appendUnique(nodes, from)
+		for _, to := range tos {
+			nodes = appendUnique(nodes, to)
+		}
+	}
+	sort.Strings(nodes)
+
+	// Tarjan's strongly connected components algorithm.
+	index := 0
+	indices := make(map[string]int)
+	lowlink := make(map[string]int)
+	onStack := make(map[string]bool)
+	var stack []string
+	var components [][]string
+
+	var connect func(node string)
+	connect = func(node string) {
+		indices[node] = index
+		lowlink[node] = index
+		index++
+		stack = append(stack, node)
+		onStack[node] = true
+
+		for _, next := range edges[node] {
+			if _, visited := indices[next]; !visited {
+				connect(next)
+				lowlink[node] = min(lowlink[node], lowlink[next])
+			} else if onStack[next] {
+				lowlink[node] = min(lowlink[node], indices[next])
+			}
+		}
+
+		if lowlink[node] == indices[node] {
+			var component []string
+			for {
+				top := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[top] = false
+				component = append(component, top)
+				if top == node {
+					break
+				}
+			}
+			components = append(components, component)
+		}
+	}
+	for _, node := range nodes {
+		if _, visited := indices[node]; !visited {
+			connect(node)
+		}
+	}
+
+	var cycles [][]string
+	for _, component := range components {
+		if len(component) == 1 && !slices.Contains(edges[component[0]], component[0]) {
+			continue
+		}
+		sort.Strings(component)
+		cycles = append(cycles, shortestCycle(component[0], edges, component))
+	}
+	sort.Slice(cycles, func(i, j int) bool {
+		return cycles[i][0] < cycles[j][0]
+	})
+	return cycles
+}
+
+// shortestCycle finds the shortest path from start back to itself using only
+// nodes in component.
+func shortestCycle(start string, edges map[string][]string, component []string) []string {
+	parents := make(map[string]string)
+	queue := []string{start}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		for _, next := range edges[node] {
+			if !slices.Contains(component, next) {
+				continue
+			}
+			if next == start {
+				cycle := []string{start}
+				for step := node; step != start; step = parents[step] {
+					cycle = append([]string{step}, cycle...)
+				}
+				return append([]string{start}, cycle...)
+			}
+			if _, seen := parents[next]; !seen {
+				parents[next] = node
+				queue = append(queue, next)
+			}
+		}
+	}
+	return []string{start, start}
+}
+
+// knownRef reports whether ref names a spec in the set, recording an unknown
+// ref error under field when it does not.
+func knownRef(ref, field string, result *ValidationResult, refToIdx map[string][]int) bool {
+	if _, ok := refToIdx[ref]; ok {
+		return true
+	}
+	result.Errors = append(result.Errors, ValidationError{
+		Field:   field,
+		Message: fmt.Sprintf("unknown spec ref %q", ref),
+		Rule:    rules.UnknownRef,
+	})
+	return false
+}
+
+func appendUnique(values []string, value string) []string {
+	if slices.Contains(values, value) {
+		return values
+	}
+	return append(values, value)
+}
@@ -0,0 +1,103 @@
+package validate
+
+import (
+	"strings"
+	"testing"
+)
+
+// parseDependencySpec builds a valid spec at specs/<dir>/SPEC.md with the
+// given extra frontmatter lines.
+func parseDependencySpec(t *testing.T, dir, frontmatter string) *Spec {
+	t.Helper()
+	content := "---\nstatus: draft\n" + frontmatter + "\n---\n\n# Feature\n"
+	spec, err := ParseSpecContent("specs/"+dir+"/SPEC.md", []byte(content))
+	if err != nil {
+		t.Fatalf("unexpected parse error: %v", err)
+	}
+	return spec
+}
+
+func errorMessages(result *ValidationResult, field string) []string {
+	var messages []string
+	for _, e := range result.Errors {
+		if e.Field == field {
+			messages = append(messages, e.Message)
+		}
+	}
+	return messages
+}
+
+func TestValidateSpecs_ValidDependencies(t *testing.T) {
+	specs := []*Spec{
+		parseDependencySpec(t, "001-base", ""),
+		parseDependencySpec(t, "001-base/010-child", ""),
+		parseDependencySpec(t, "002-feature", "depends_on: [1, 1.10]\nblocks: 3"),
+		parseDependencySpec(t, "003-follow-up", "depends_on: 001"),
+	}
+
+	for _, result := range ValidateSpecs(specs) {
+		if !result.IsValid() {
+			t.Errorf("%s: expected valid dependencies, got %v", result.Path, result.Errors)
+		}
+	}
+}
+
+func TestValidateSpecs_UnknownDependencyRefs(t *testing.T) {
+	specs := []*Spec{
+		parseDependencySpec(t, "001-base", ""),
+		parseDependencySpec(t, "002-feature", "depends_on: [1, 9]\nblocks: [1.4]"),
+	}
+
+	results := ValidateSpecs(specs)
+	if got := errorMessages(results[1], "depends_on"); len(got) != 1 || got[0] != `unknown spec ref "9"` {
+		t.Errorf("expected unknown depends_on ref error, got %v", got)
+	}
+	if got := errorMessages(results[1], "blocks"); len(got) != 1 || got[0] != `unknown spec ref "1.4"` {
+		t.Errorf("expected unknown blocks ref error, got %v", got)
+	}
+	if !results[0].IsValid() {
+		t.Errorf("expected referenced spec to stay valid, got %v", results[0].Errors)
+	}
+}
+
+func TestValidateSpec_MalformedDependencyFields(t *testing.T) {
+	spec := parseDependencySpec(t, "001-feature", "depends_on:\n  ref: 2\nblocks: [abc, [3]]")
+
+	result := ValidateSpec(spec)
+	if got := errorMessages(result, "depends_on"); len(got) != 1 || !strings.Contains(got[0], "list of spec references") {
+		t.Errorf("expected malformed depends_on error, got %v", got)
+	}
+	if got := errorMessages(result, "blocks"); len(got) != 1 {
+		t.Errorf("expected malformed blocks error, got %v", got)
+	}
+
+	spec = parseDependencySpec(t, "001-feature", "depends_on: [abc]")
+	result = ValidateSpec(spec)
+	if got := errorMessages(result, "depends_on"); len(got) != 1 || got[0] != `invalid spec ref "abc"` {
+		t.Errorf("expected invalid ref error, got %v", got)
+	}
+}
+
+func TestValidateSpecs_DependencyCycles(t *testing.T) {
+	specs := []*Spec{
+		parseDependencySpec(t, "001-a", "depends_on: 2"),
+		parseDependencySpec(t, "002-b", "blocks: 3"),
+		parseDependencySpec(t, "003-c", "blocks: 2"),
+		parseDependencySpec(t, "004-d", "depends_on: 1"),
+		parseDependencySpec(t, "005-e", "depends_on: 5"),
+	}
+
+	results := ValidateSpecs(specs)
+	for _, idx := range []int{1, 2} {
+		got := errorMessages(results[idx], "depends_on")
+		if len(got) != 1 || got[0] != "dependency cycle: 2 → 3 → 2" {
+			t.Errorf("spec %d: expected 2 → 3 cycle error, got %v", idx+1, got)
+		}
+	}
+	if !results[0].IsValid() || !results[3].IsValid() {
+		t.Errorf("expected specs outside the cycle to stay valid, got %v and %v", results[0].Errors, results[3].Errors)
+	}
+	if got := errorMessages(results[4], "depends_on"); len(got) != 1 || got[0] != "dependency cycle: 5 → 5" {
+		t.Errorf("expected self-dependency cycle error, got %v", got)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/yuin/goldmark/parser"
 	"github.com/yuin/goldmark/text"
 	gmfrontmatter "go.abhg.dev/goldmark/frontmatter"
+	"gopkg.in/yaml.v3"
 )
 
 // ValidStatus contains the valid status values for a spec.
@@ -21,6 +22,9 @@ var ValidStatus = []string{"draft", "approved", "in-progress", "completed", "rej
 type Frontmatter struct {
 	Status string `yaml:"status"`
 	Author string `yaml:"author"`
+	// Fields holds every frontmatter value as an undecoded YAML node, so
+	// rules can inspect a field's shape and source text before trusting it.
+	Fields map[string]yaml.Node `yaml:"-"`
 }
 
 // Spec represents a parsed spec file for validation purposes.
@@ -30,7 +34,10 @@ type Frontmatter struct {
 // purpose: it retains the raw goldmark AST (Document) and source bytes needed
 // by the validator.
 type Spec struct {
-	Path        string
+	Path string
+	// SpecsDir is the specs directory the spec was found in. When empty, the
+	// spec's ref is derived from the path below its last "specs" element.
+	SpecsDir    string
 	Frontmatter *Frontmatter
 	Title       string
 	Source      []byte
@@ -92,6 +99,9 @@ func extractFrontmatter(ctx parser.Context) *Frontmatter {
 	if err := fmData.Decode(&fm); err != nil {
 		return nil
 	}
+	if err := fmData.Decode(&fm.Fields); err != nil {
+		return nil
+	}
 
 	return &fm
 }
@@ -0,0 +1,329 @@
+package validate
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/specture-system/specture/internal/rules"
+)
+
+// ReportFormats lists the machine-readable formats WriteReport accepts.
+var ReportFormats = []string{"json", "junit", "sarif", "github"}
+
+// Report is the outcome of validating a set of specs.
+type Report struct {
+	Results []*ValidationResult
+	// Root is the directory report paths are relative to, usually the
+	// project root holding the specs directory.
+	Root string
+	// ToolVersion is the specture version recorded in SARIF reports.
+	ToolVersion string
+}
+
+// Finding is one error or warning of a validation result.
+type Finding struct {
+	Rule     string
+	Severity rules.Severity
+	Field    string
+	Message  string
+}
+
+// Text formats the finding as "field: message".
+func (f Finding) Text() string {
+	return fmt.Sprintf("%s: %s", f.Field, f.Message)
+}
+
+// Findings returns the result's errors followed by its warnings.
+func (r *ValidationResult) Findings() []Finding {
+	findings := make([]Finding, 0, len(r.Errors)+len(r.Warnings))
+	for _, e := range r.Errors {
+		findings = append(findings, Finding{Rule: e.Rule, Severity: rules.SeverityError, Field: e.Field, Message: e.Message})
+	}
+	for _, w := range r.Warnings {
+		findings = append(findings, Finding{Rule: w.Rule, Severity: rules.SeverityWarning, Field: w.Field, Message: w.Message})
+	}
+	return findings
+}
+
+// WriteReport writes the report to w in one of ReportFormats.
+func WriteReport(w io.Writer, format string, report Report) error {
+	switch format {
+	case "json":
+		return writeJSONReport(w, report)
+	case "junit":
+		return writeJUnitReport(w, report)
+	case "sarif":
+		return writeSARIFReport(w, report)
+	case "github":
+		return writeGitHubReport(w, report)
+	}
+	return fmt.Errorf("invalid report format: %s (must be one of: %s)", format, strings.Join(ReportFormats, ", "))
+}
+
+// path returns the result's path relative to the report root with forward
+// slashes, as CI tools expect.
+func (r Report) path(result *ValidationResult) string {
+	path := result.Path
+	if r.Root != "" {
+		if abs, err := filepath.Abs(path); err == nil {
+			if rel, err := filepath.Rel(r.Root, abs); err == nil && !strings.HasPrefix(rel, "..") {
+				path = rel
+			}
+		}
+	}
+	return filepath.ToSlash(path)
+}
+
+// counts returns the number of invalid results, errors, and warnings.
+func (r Report) counts() (invalid, errors, warnings int) {
+	for _, result := range r.Results {
+		if !result.IsValid() {
+			invalid++
+		}
+		errors += len(result.Errors)
+		warnings += len(result.Warnings)
+	}
+	return invalid, errors, warnings
+}
+
+type jsonReport struct {
+	Specs    int                `json:"specs"`
+	Valid    int                `json:"valid"`
+	Invalid  int                `json:"invalid"`
+	Errors   int                `json:"errors"`
+	Warnings int                `json:"warnings"`
+	Results  []jsonReportResult `json:"results"`
+}
+
+type jsonReportResult struct {
+	Path     string              `json:"path"`
+	Valid    bool                `json:"valid"`
+	Findings []jsonReportFinding `json:"findings"`
+}
+
+type jsonReportFinding struct {
+	Rule     string         `json:"rule"`
+	Severity rules.Severity `json:"severity"`
+	Field    string         `json:"field"`
+	Message  string         `json:"message"`
+}
+
+// writeJSONReport writes every result with its findings and summary counts.
+func writeJSONReport(w io.Writer, report Report) error {
+	invalid, errors, warnings := report.counts()
+	output := jsonReport{
+		Specs:    len(report.Results),
+		Valid:    len(report.Results) - invalid,
+		Invalid:  invalid,
+		Errors:   errors,
+		Warnings: warnings,
+		Results:  make([]jsonReportResult, 0, len(report.Results)),
+	}
+	for _, result := range report.Results {
+		entry := jsonReportResult{Path: report.path(result), Valid: result.IsValid(), Findings: []jsonReportFinding{}}
+		for _, f := range result.Findings() {
+			entry.Findings = append(entry.Findings, jsonReportFinding{Rule: f.Rule, Severity: f.Severity, Field: f.Field, Message: f.Message})
+		}
+		output.Results = append(output.Results, entry)
+	}
+
+	data, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JSON: %w", err)
+	}
+	_, err = fmt.Fprintln(w, string(data))
+	return err
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Errors   int             `xml:"errors,attr"`
+	Skipped  int             `xml:"skipped,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	ClassName string        `xml:"classname,attr"`
+	Name      string        `xml:"name,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitFailure struct {
+	Type    string `xml:"type,attr"`
+	Message string `xml:"message,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// writeJUnitReport writes one test case per spec. A spec with errors fails
+// with every error in the failure text; warnings go to system-out.
+func writeJUnitReport(w io.Writer, report Report) error {
+	invalid, _, _ := report.counts()
+	suite := junitTestSuite{Name: "specture validate", Tests: len(report.Results), Failures: invalid}
+	for _, result := range report.Results {
+		testCase := junitTestCase{ClassName: "specture.validate", Name: report.path(result)}
+		var failures, warnings []string
+		for _, f := range result.Findings() {
+			line := fmt.Sprintf("[%s] %s", f.Rule, f.Text())
+			if f.Severity == rules.SeverityError {
+				failures = append(failures, line)
+			} else {
+				warnings = append(warnings, "warning: "+line)
+			}
+		}
+		if len(failures) > 0 {
+			first := result.Errors[0]
+			testCase.Failure = &junitFailure{
+				Type:    first.Rule,
+				Message: first.Error(),
+				Text:    strings.Join(failures, "\n"),
+			}
+		}
+		testCase.SystemOut = strings.Join(warnings, "\n")
+		suite.Cases = append(suite.Cases, testCase)
+	}
+
+	output := junitTestSuites{Name: suite.Name, Tests: suite.Tests, Failures: suite.Failures, Suites: []junitTestSuite{suite}}
+	data, err := xml.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal JUnit XML: %w", err)
+	}
+	_, err = fmt.Fprintf(w, "%s%s\n", xml.Header, data)
+	return err
+}
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version,omitempty"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	RuleIndex int             `json:"ruleIndex"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+// writeSARIFReport writes a SARIF 2.1.0 log with one result per finding, for
+// code scanning tools.
+func writeSARIFReport(w io.Writer, report Report) error {
+	driver := sarifDriver{
+		Name:           "specture",
+		Version:        report.ToolVersion,
+		InformationURI: "https://github.com/specture-system/specture",
+		Rules:          make([]sarifRule, len(rules.All)),
+	}
+	ruleIndex := make(map[string]int, len(rules.All))
+	for i, rule := range rules.All {
+		driver.Rules[i] = sarifRule{ID: rule, ShortDescription: sarifMessage{Text: rules.Descriptions[rule]}}
+		ruleIndex[rule] = i
+	}
+
+	run := sarifRun{Tool: sarifTool{Driver: driver}, Results: []sarifResult{}}
+	for _, result := range report.Results {
+		location := sarifLocation{PhysicalLocation: sarifPhysicalLocation{
+			ArtifactLocation: sarifArtifactLocation{URI: report.path(result)},
+		}}
+		for _, f := range result.Findings() {
+			run.Results = append(run.Results, sarifResult{
+				RuleID:    f.Rule,
+				RuleIndex: ruleIndex[f.Rule],
+				Level:     string(f.Severity),
+				Message:   sarifMessage{Text: f.Text()},
+				Locations: []sarifLocation{location},
+			})
+		}
+	}
+
+	output := sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{run},
+	}
+	data, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal SARIF: %w", err)
+	}
+	_, err = fmt.Fprintln(w, string(data))
+	return err
+}
+
+// writeGitHubReport writes a GitHub Actions workflow command per finding, so
+// findings show up as annotations on the spec files, then a summary line.
+func writeGitHubReport(w io.Writer, report Report) error {
+	for _, result := range report.Results {
+		file := escapeGitHubProperty(report.path(result))
+		for _, f := range result.Findings() {
+			title := escapeGitHubProperty("specture " + f.Rule)
+			if _, err := fmt.Fprintf(w, "::%s file=%s,title=%s::%s\n", f.Severity, file, title, escapeGitHubData(f.Text())); err != nil {
+				return err
+			}
+		}
+	}
+	invalid, _, _ := report.counts()
+	_, err := fmt.Fprintf(w, "%d of %d specs valid\n", len(report.Results)-invalid, len(report.Results))
+	return err
+}
+
+// escapeGitHubData escapes a workflow command message.
+func escapeGitHubData(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A").Replace(s)
+}
+
+// escapeGitHubProperty escapes a workflow command property value.
+func escapeGitHubProperty(s string) string {
+	return strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C").Replace(s)
+}
@@ -0,0 +1,166 @@
+package validate
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/specture-system/specture/internal/rules"
+)
+
+func testReport(t *testing.T) Report {
+	t.Helper()
+	root := t.TempDir()
+	return Report{
+		Root:        root,
+		ToolVersion: "1.2.3",
+		Results: []*ValidationResult{
+			{Path: filepath.Join(root, "specs", "001-valid", "SPEC.md")},
+			{
+				Path: filepath.Join(root, "specs", "002-invalid", "SPEC.md"),
+				Errors: []ValidationError{
+					{Field: "status", Message: "invalid status: bogus", Rule: rules.Status},
+					{Field: "depends_on", Message: "unknown ref 9, 10%", Rule: rules.UnknownRef},
+				},
+				Warnings: []ValidationError{
+					{Field: "labels", Message: "unknown label: ux", Rule: rules.Labels},
+				},
+			},
+		},
+	}
+}
+
+func TestWriteReport_JSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteReport(&buf, "json", testReport(t)); err != nil {
+		t.Fatalf("WriteReport() error = %v", err)
+	}
+
+	var got jsonReport
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, buf.String())
+	}
+	if got.Specs != 2 || got.Valid != 1 || got.Invalid != 1 || got.Errors != 2 || got.Warnings != 1 {
+		t.Errorf("counts = %+v", got)
+	}
+	if got.Results[0].Findings == nil || len(got.Results[0].Findings) != 0 {
+		t.Errorf("valid spec findings = %v, want empty list", got.Results[0].Findings)
+	}
+	result := got.Results[1]
+	if result.Path != "specs/002-invalid/SPEC.md" || result.Valid {
+		t.Errorf("result = %+v", result)
+	}
+	want := []jsonReportFinding{
+		{Rule: rules.Status, Severity: rules.SeverityError, Field: "status", Message: "invalid status: bogus"},
+		{Rule: rules.UnknownRef, Severity: rules.SeverityError, Field: "depends_on", Message: "unknown ref 9, 10%"},
+		{Rule: rules.Labels, Severity: rules.SeverityWarning, Field: "labels", Message: "unknown label: ux"},
+	}
+	for i, finding := range want {
+		if i >= len(result.Findings) || result.Findings[i] != finding {
+			t.Errorf("findings = %+v, want %+v", result.Findings, want)
+			break
+		}
+	}
+}
+
+func TestWriteReport_JUnit(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteReport(&buf, "junit", testReport(t)); err != nil {
+		t.Fatalf("WriteReport() error = %v", err)
+	}
+
+	var got junitTestSuites
+	if err := xml.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("invalid XML: %v\n%s", err, buf.String())
+	}
+	if got.Tests != 2 || got.Failures != 1 || len(got.Suites) != 1 || len(got.Suites[0].Cases) != 2 {
+		t.Fatalf("report = %+v", got)
+	}
+	passed, failed := got.Suites[0].Cases[0], got.Suites[0].Cases[1]
+	if passed.Name != "specs/001-valid/SPEC.md" || passed.Failure != nil {
+		t.Errorf("passing case = %+v", passed)
+	}
+	if failed.Failure == nil {
+		t.Fatalf("failing case has no failure: %+v", failed)
+	}
+	if failed.Failure.Type != rules.Status || failed.Failure.Message != "status: invalid status: bogus" {
+		t.Errorf("failure = %+v", failed.Failure)
+	}
+	if want := "[status] status: invalid status: bogus\n[unknown-ref] depends_on: unknown ref 9, 10%"; failed.Failure.Text != want {
+		t.Errorf("failure text = %q, want %q", failed.Failure.Text, want)
+	}
+	if want := "warning: [labels] labels: unknown label: ux"; failed.SystemOut != want {
+		t.Errorf("system-out = %q, want %q", failed.SystemOut, want)
+	}
+}
+
+func TestWriteReport_SARIF(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteReport(&buf, "sarif", testReport(t)); err != nil {
+		t.Fatalf("WriteReport() error = %v", err)
+	}
+
+	var got sarifLog
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, buf.String())
+	}
+	if got.Version != "2.1.0" || len(got.Runs) != 1 {
+		t.Fatalf("log = %+v", got)
+	}
+	run := got.Runs[0]
+	if run.Tool.Driver.Version != "1.2.3" || len(run.Tool.Driver.Rules) != len(rules.All) {
+		t.Errorf("driver = %+v", run.Tool.Driver)
+	}
+	for _, rule := range run.Tool.Driver.Rules {
+		if rule.ShortDescription.Text == "" {
+			t.Errorf("rule %s has no description", rule.ID)
+		}
+	}
+	if len(run.Results) != 3 {
+		t.Fatalf("results = %+v", run.Results)
+	}
+	warning := run.Results[2]
+	if warning.RuleID != rules.Labels || warning.Level != "warning" || warning.Message.Text != "labels: unknown label: ux" {
+		t.Errorf("warning = %+v", warning)
+	}
+	if rule := run.Tool.Driver.Rules[warning.RuleIndex]; rule.ID != rules.Labels {
+		t.Errorf("ruleIndex %d points at %s", warning.RuleIndex, rule.ID)
+	}
+	if uri := warning.Locations[0].PhysicalLocation.ArtifactLocation.URI; uri != "specs/002-invalid/SPEC.md" {
+		t.Errorf("uri = %q", uri)
+	}
+}
+
+func TestWriteReport_GitHub(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteReport(&buf, "github", testReport(t)); err != nil {
+		t.Fatalf("WriteReport() error = %v", err)
+	}
+
+	want := strings.Join([]string{
+		"::error file=specs/002-invalid/SPEC.md,title=specture status::status: invalid status: bogus",
+		"::error file=specs/002-invalid/SPEC.md,title=specture unknown-ref::depends_on: unknown ref 9, 10%25",
+		"::warning file=specs/002-invalid/SPEC.md,title=specture labels::labels: unknown label: ux",
+		"1 of 2 specs valid",
+		"",
+	}, "\n")
+	if got := buf.String(); got != want {
+		t.Errorf("output =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestWriteReport_InvalidFormat(t *testing.T) {
+	err := WriteReport(&bytes.Buffer{}, "yaml", Report{})
+	if err == nil || !strings.Contains(err.Error(), "invalid report format") {
+		t.Errorf("WriteReport() error = %v, want invalid report format", err)
+	}
+}
+
+func TestEscapeGitHubProperty(t *testing.T) {
+	if got, want := escapeGitHubProperty("a:b,c%\nd"), "a%3Ab%2Cc%25%0Ad"; got != want {
+		t.Errorf("escapeGitHubProperty() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,93 @@
+package validate
+
+import (
+	"sort"
+
+	"github.com/specture-system/specture/internal/rules"
+	"github.com/specture-system/specture/internal/workflow"
+)
+
+// Options configures validation.
+type Options struct {
+	// Statuses lists the allowed status values.
+	Statuses []string
+	// RequiredFields lists the frontmatter fields every spec must set.
+	RequiredFields []string
+	// Labels lists the allowed labels. When empty, any label is allowed.
+	Labels []string
+	// Priorities lists the allowed priority values. When empty, any
+	// priority is allowed.
+	Priorities []string
+	// Severities overrides the severity of individual rules. Rules that are
+	// not listed report errors.
+	Severities map[string]rules.Severity
+	// Workflow restricts status changes between BaseStatuses and the
+	// current statuses.
+	Workflow workflow.Workflow
+	// BaseStatuses maps the refs of the specs at a base revision to their
+	// status there, as built by BaseStatuses. Specs are matched by ref, so a
+	// spec moved by specture rename keeps its history. When nil, status
+	// transitions are not checked. A spec whose ref has no entry is new since
+	// the base revision.
+	BaseStatuses map[string]string
+}
+
+// DefaultOptions returns the options used when a project has no
+// configuration.
+func DefaultOptions() Options {
+	return Options{
+		Statuses:       ValidStatus,
+		RequiredFields: []string{"status"},
+	}
+}
+
+// NewOptions builds validation options from configured values, rejecting
+// unknown rule names and severities.
+func NewOptions(statuses, requiredFields []string, severities map[string]rules.Severity) (Options, error) {
+	opts := Options{
+		Statuses:       statuses,
+		RequiredFields: requiredFields,
+		Severities:     make(map[string]rules.Severity, len(severities)),
+	}
+
+	names := make([]string, 0, len(severities))
+	for name := range severities {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := rules.Check(name, severities[name]); err != nil {
+			return Options{}, err
+		}
+		opts.Severities[name] = severities[name]
+	}
+	return opts, nil
+}
+
+// severity returns the configured severity of rule.
+func (o Options) severity(rule string) rules.Severity {
+	if severity, ok := o.Severities[rule]; ok {
+		return severity
+	}
+	return rules.SeverityError
+}
+
+// applySeverities moves findings of warning rules into Warnings and drops
+// findings of disabled rules.
+func applySeverities(result *ValidationResult, opts Options) {
+	if len(opts.Severities) == 0 {
+		return
+	}
+
+	errs := result.Errors[:0]
+	for _, e := range result.Errors {
+		switch opts.severity(e.Rule) {
+		case rules.SeverityOff:
+		case rules.SeverityWarning:
+			result.Warnings = append(result.Warnings, e)
+		default:
+			errs = append(errs, e)
+		}
+	}
+	result.Errors = errs
+}
@@ -0,0 +1,94 @@
+package validate
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/specture-system/specture/internal/rules"
+	"github.com/specture-system/specture/internal/workflow"
+)
+
+func TestNewOptions_RejectsUnknownRules(t *testing.T) {
+	if _, err := NewOptions(ValidStatus, nil, map[string]rules.Severity{"no-such-rule": "off"}); err == nil {
+		t.Error("expected error for unknown rule")
+	}
+	if _, err := NewOptions(ValidStatus, nil, map[string]rules.Severity{rules.Title: "fatal"}); err == nil {
+		t.Error("expected error for invalid severity")
+	}
+}
+
+func TestValidateSpecsWithOptions(t *testing.T) {
+	content := "---\nstatus: deferred\n---\n\n## 1. Numbered\n"
+	spec, err := ParseSpecContent("specs/001-x/SPEC.md", []byte(content))
+	if err != nil {
+		t.Fatalf("unexpected parse error: %v", err)
+	}
+
+	opts, err := NewOptions(
+		[]string{"draft", "deferred"},
+		[]string{"status", "author"},
+		map[string]rules.Severity{rules.NumberedHeadings: "warning", rules.Title: "off"},
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	result := ValidateSpecsWithOptions([]*Spec{spec}, opts)[0]
+	if len(result.Errors) != 1 || result.Errors[0].Field != "author" || result.Errors[0].Rule != rules.RequiredFields {
+		t.Errorf("expected only the missing author error, got %v", result.Errors)
+	}
+	if len(result.Warnings) != 1 || result.Warnings[0].Rule != rules.NumberedHeadings {
+		t.Errorf("expected numbered heading warning, got %v", result.Warnings)
+	}
+}
+
+func TestValidateSpecsWithOptions_StatusTransitions(t *testing.T) {
+	parse := func(path, status string) *Spec {
+		spec, err := ParseSpecContent(path, []byte("---\nstatus: "+status+"\n---\n\n# Spec\n"))
+		if err != nil {
+			t.Fatalf("unexpected parse error: %v", err)
+		}
+		return spec
+	}
+	specs := []*Spec{
+		parse("specs/001-a/SPEC.md", "completed"),
+		parse("specs/002-b/SPEC.md", "approved"),
+		parse("specs/003-c/SPEC.md", "approved"),
+	}
+
+	opts := DefaultOptions()
+	opts.Workflow = workflow.Workflow{
+		Transitions: map[string][]string{"draft": {"approved"}},
+	}
+	opts.BaseStatuses = map[string]string{
+		"1": "draft",
+		"2": "draft",
+	}
+
+	results := ValidateSpecsWithOptions(specs, opts)
+	if len(results[0].Errors) != 1 || results[0].Errors[0].Rule != rules.StatusTransition {
+		t.Errorf("expected illegal transition error, got %v", results[0].Errors)
+	}
+	if !results[1].IsValid() || !results[2].IsValid() {
+		t.Errorf("expected allowed transition and unrestricted new spec to be valid, got %v and %v", results[1].Errors, results[2].Errors)
+	}
+
+	opts.BaseStatuses = nil
+	if results := ValidateSpecsWithOptions(specs, opts); !results[0].IsValid() {
+		t.Errorf("expected no transition checks without base statuses, got %v", results[0].Errors)
+	}
+}
+
+func TestBaseStatuses(t *testing.T) {
+	files := map[string][]byte{
+		"001-a/SPEC.md":           []byte("---\nstatus: approved\n---\n\n# A\n"),
+		"001-a/PLAN.md":           []byte("---\nstatus: draft\n---\n\n# A plan\n"),
+		"001-a/002-child/PLAN.md": []byte("---\nstatus: in-progress\n---\n\n# Child\n"),
+		"003-untracked/SPEC.md":   []byte("# No frontmatter\n"),
+		"drafts/SPEC.md":          []byte("---\nstatus: draft\n---\n\n# Unnumbered\n"),
+	}
+	want := map[string]string{"1": "approved", "1.2": "in-progress", "3": "draft"}
+	if got := BaseStatuses(files); !maps.Equal(got, want) {
+		t.Errorf("BaseStatuses() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,51 @@
+package validate
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/specture-system/specture/internal/rules"
+)
+
+// supersessionFields are the frontmatter fields recording that one spec
+// replaces another.
+var supersessionFields = []string{"supersedes", "superseded_by"}
+
+// validateSupersession rejects unknown refs in supersession fields and
+// relations recorded on only one side: when spec A lists B in supersedes, B
+// must list A in superseded_by, and the other way around. The error is
+// reported on the spec whose list is missing the counterpart.
+func validateSupersession(specs []*Spec, results []*ValidationResult, refToIdx map[string][]int) {
+	for i, spec := range specs {
+		fullRef := specFullRef(spec)
+		for _, field := range supersessionFields {
+			counterpart := "superseded_by"
+			if field == "superseded_by" {
+				counterpart = "supersedes"
+			}
+
+			for _, ref := range frontmatterRefs(spec, field) {
+				if !knownRef(ref, field, results[i], refToIdx) || fullRef == "" {
+					continue
+				}
+				if ref == fullRef {
+					results[i].Errors = append(results[i].Errors, ValidationError{
+						Field:   field,
+						Message: "a spec cannot supersede itself",
+						Rule:    rules.Supersession,
+					})
+					continue
+				}
+				for _, idx := range refToIdx[ref] {
+					if !slices.Contains(frontmatterRefs(specs[idx], counterpart), fullRef) {
+						results[idx].Errors = append(results[idx].Errors, ValidationError{
+							Field:   counterpart,
+							Message: fmt.Sprintf("must include %s because spec %s lists this spec in %s", fullRef, fullRef, field),
+							Rule:    rules.Supersession,
+						})
+					}
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package validate
+
+import "testing"
+
+func TestValidateSpecs_Supersession(t *testing.T) {
+	specs := []*Spec{
+		parseDependencySpec(t, "001-old", "superseded_by: 3"),
+		parseDependencySpec(t, "002-legacy", ""),
+		parseDependencySpec(t, "003-new", "supersedes: [1, 2, 9]"),
+		parseDependencySpec(t, "004-orphan", "superseded_by: 3"),
+		parseDependencySpec(t, "005-self", "supersedes: 5"),
+	}
+
+	results := ValidateSpecs(specs)
+	if !results[0].IsValid() {
+		t.Errorf("expected matching supersession to be valid, got %v", results[0].Errors)
+	}
+	if got := errorMessages(results[1], "superseded_by"); len(got) != 1 || got[0] != "must include 3 because spec 3 lists this spec in supersedes" {
+		t.Errorf("expected missing superseded_by error, got %v", got)
+	}
+	if got := errorMessages(results[2], "supersedes"); len(got) != 2 ||
+		got[0] != `unknown spec ref "9"` ||
+		got[1] != "must include 4 because spec 4 lists this spec in superseded_by" {
+		t.Errorf("expected unknown ref and missing supersedes errors, got %v", got)
+	}
+	if !results[3].IsValid() {
+		t.Errorf("expected one-sided relation to be reported on the other spec, got %v", results[3].Errors)
+	}
+	if got := errorMessages(results[4], "supersedes"); len(got) != 1 || got[0] != "a spec cannot supersede itself" {
+		t.Errorf("expected self-supersession error, got %v", got)
+	}
+}
+
+func TestValidateSpec_MalformedSupersessionFields(t *testing.T) {
+	spec := parseDependencySpec(t, "001-feature", "supersedes:\n  ref: 2")
+
+	result := ValidateSpec(spec)
+	if got := errorMessages(result, "supersedes"); len(got) != 1 {
+		t.Errorf("expected malformed supersedes error, got %v", got)
+	}
+}
@@ -0,0 +1,94 @@
+package validate
+
+import (
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/specture-system/specture/internal/rules"
+	specpkg "github.com/specture-system/specture/internal/spec"
+)
+
+// validateTransitions checks each spec's status change since the base
+// revision against the workflow. New specs must start in an initial status.
+// Specs without a status count as drafts on either side, as they do in
+// discovery.
+func validateTransitions(specs []*Spec, results []*ValidationResult, opts Options) {
+	if opts.BaseStatuses == nil {
+		return
+	}
+
+	for i, spec := range specs {
+		status := specStatus(spec)
+		// Specs without a ref fail the spec-path rule instead.
+		ref := specFullRef(spec)
+		if ref == "" {
+			continue
+		}
+
+		base, existed := opts.BaseStatuses[ref]
+		if !existed {
+			if !opts.Workflow.AllowsInitial(status) {
+				results[i].Errors = append(results[i].Errors, ValidationError{
+					Field:   "status",
+					Message: fmt.Sprintf("new specs must start as one of: %s (found %q)", strings.Join(opts.Workflow.Initial, ", "), status),
+					Rule:    rules.StatusTransition,
+				})
+			}
+			continue
+		}
+		if opts.Workflow.Allows(base, status) {
+			continue
+		}
+
+		allowed := "none"
+		if next := opts.Workflow.Next(base); len(next) > 0 {
+			allowed = strings.Join(next, ", ")
+		}
+		results[i].Errors = append(results[i].Errors, ValidationError{
+			Field:   "status",
+			Message: fmt.Sprintf("illegal transition %s → %s (allowed from %s: %s)", base, status, base, allowed),
+			Rule:    rules.StatusTransition,
+		})
+	}
+}
+
+// BaseStatuses maps the ref of each spec in files to its status. files holds
+// the SPEC.md and PLAN.md contents at a base revision, keyed by path relative
+// to the specs directory with forward slashes. As in discovery, a SPEC.md is
+// preferred over the PLAN.md beside it, and the first path claiming a ref
+// wins.
+func BaseStatuses(files map[string][]byte) map[string]string {
+	paths := slices.Sorted(maps.Keys(files))
+	statuses := make(map[string]string, len(paths))
+	for _, p := range paths {
+		dir := path.Dir(p)
+		if path.Base(p) == "PLAN.md" {
+			if _, ok := files[path.Join(dir, "SPEC.md")]; ok {
+				continue
+			}
+		}
+		ref := fullRefFromParts(strings.Split(dir, "/"))
+		if _, claimed := statuses[ref]; ref == "" || claimed {
+			continue
+		}
+		status := specpkg.InferStatus("")
+		if spec, err := ParseSpecContent(p, files[p]); err == nil {
+			status = specStatus(spec)
+		}
+		statuses[ref] = status
+	}
+	return statuses
+}
+
+// specStatus returns the status of spec, inferred as spec.Parse does when
+// the frontmatter does not set one.
+func specStatus(spec *Spec) string {
+	var status string
+	if spec.Frontmatter != nil {
+		status = spec.Frontmatter.Status
+	}
+	return specpkg.InferStatus(status)
+}
@@ -7,6 +7,10 @@ import (
 	"slices"
 	"strconv"
 	"strings"
+
+	"github.com/specture-system/specture/internal/rules"
+	specpkg "github.com/specture-system/specture/internal/spec"
+	"gopkg.in/yaml.v3"
 )
 
 var (
@@ -19,6 +23,8 @@ var (
 type ValidationError struct {
 	Field   string
 	Message string
+	// Rule names the validation rule that produced the error.
+	Rule string
 }
 
 func (e ValidationError) Error() string {
@@ -37,17 +43,24 @@ func (r *ValidationResult) IsValid() bool {
 	return len(r.Errors) == 0
 }
 
-// ValidateSpec validates a spec and returns the validation result
+// ValidateSpec validates a spec with the default options and returns the
+// validation result
 func ValidateSpec(spec *Spec) *ValidationResult {
+	return validateSpec(spec, DefaultOptions())
+}
+
+// validateSpec runs the single-spec checks without applying rule severities.
+func validateSpec(spec *Spec, opts Options) *ValidationResult {
 	result := &ValidationResult{
 		Path:   spec.Path,
 		Errors: []ValidationError{},
 	}
 
-	if fullRefFromPath(spec.Path) == "" {
+	if specFullRef(spec) == "" {
 		result.Errors = append(result.Errors, ValidationError{
 			Field:   "path",
 			Message: "spec path must encode a numbered ref",
+			Rule:    rules.SpecPath,
 		})
 	}
 
@@ -56,27 +69,40 @@ func ValidateSpec(spec *Spec) *ValidationResult {
 		result.Errors = append(result.Errors, ValidationError{
 			Field:   "frontmatter",
 			Message: "missing frontmatter",
+			Rule:    rules.Frontmatter,
 		})
 	} else {
+		for _, field := range opts.RequiredFields {
+			if !hasFrontmatterValue(spec.Frontmatter, field) {
+				result.Errors = append(result.Errors, ValidationError{
+					Field:   field,
+					Message: "missing required field",
+					Rule:    rules.RequiredFields,
+				})
+			}
+		}
+
 		// Validate status field
-		if spec.Frontmatter.Status == "" {
-			result.Errors = append(result.Errors, ValidationError{
-				Field:   "status",
-				Message: "missing required field",
-			})
-		} else if !slices.Contains(ValidStatus, spec.Frontmatter.Status) {
+		if spec.Frontmatter.Status != "" && !slices.Contains(opts.Statuses, spec.Frontmatter.Status) {
 			result.Errors = append(result.Errors, ValidationError{
 				Field:   "status",
-				Message: fmt.Sprintf("invalid value %q (must be one of: draft, approved, in-progress, completed, rejected)", spec.Frontmatter.Status),
+				Message: fmt.Sprintf("invalid value %q (must be one of: %s)", spec.Frontmatter.Status, strings.Join(opts.Statuses, ", ")),
+				Rule:    rules.Status,
 			})
 		}
 	}
 
+	validateRefListFields(spec, result)
+	validateAssignee(spec, result)
+	validateLabels(spec, opts.Labels, result)
+	validatePriority(spec, opts.Priorities, result)
+
 	// Validate title (H1 heading) exists
 	if spec.Title == "" {
 		result.Errors = append(result.Errors, ValidationError{
 			Field:   "title",
 			Message: "missing H1 heading",
+			Rule:    rules.Title,
 		})
 	}
 
@@ -84,12 +110,118 @@ func ValidateSpec(spec *Spec) *ValidationResult {
 		result.Errors = append(result.Errors, ValidationError{
 			Field:   "headings",
 			Message: fmt.Sprintf("section headers must not be numbered (found %q)", numberedHeading),
+			Rule:    rules.NumberedHeadings,
 		})
 	}
 
 	return result
 }
 
+// validateAssignee checks that assignee is a name or a list of names. Names
+// must not contain commas, since lists of names are written and filtered
+// comma-separated.
+func validateAssignee(spec *Spec, result *ValidationResult) {
+	if spec.Frontmatter == nil {
+		return
+	}
+	node, ok := spec.Frontmatter.Fields["assignee"]
+	if !ok {
+		return
+	}
+	names, err := specpkg.ParseStringList(&node)
+	if err != nil {
+		result.Errors = append(result.Errors, ValidationError{
+			Field:   "assignee",
+			Message: "must be a name or a list of names",
+			Rule:    rules.Assignee,
+		})
+		return
+	}
+	for _, name := range names {
+		if strings.Contains(name, ",") {
+			result.Errors = append(result.Errors, ValidationError{
+				Field:   "assignee",
+				Message: fmt.Sprintf("name %q must not contain a comma; list each assignee separately", name),
+				Rule:    rules.Assignee,
+			})
+		}
+	}
+}
+
+// validateLabels checks that labels is a label or a list of labels, that
+// each label can be used in a list --label filter, and, when allowed is not
+// empty, that each label is one of allowed.
+func validateLabels(spec *Spec, allowed []string, result *ValidationResult) {
+	if spec.Frontmatter == nil {
+		return
+	}
+	node, ok := spec.Frontmatter.Fields["labels"]
+	if !ok {
+		return
+	}
+	labels, err := specpkg.ParseStringList(&node)
+	if err != nil {
+		result.Errors = append(result.Errors, ValidationError{
+			Field:   "labels",
+			Message: "must be a label or a list of labels",
+			Rule:    rules.Labels,
+		})
+		return
+	}
+	for _, label := range labels {
+		switch {
+		case strings.ContainsAny(label, ", ") || strings.HasPrefix(label, "!"):
+			result.Errors = append(result.Errors, ValidationError{
+				Field:   "labels",
+				Message: fmt.Sprintf("invalid label %q (labels must not contain spaces or commas or start with !)", label),
+				Rule:    rules.Labels,
+			})
+		case len(allowed) > 0 && !slices.Contains(allowed, label):
+			result.Errors = append(result.Errors, ValidationError{
+				Field:   "labels",
+				Message: fmt.Sprintf("unknown label %q (allowed: %s)", label, strings.Join(allowed, ", ")),
+				Rule:    rules.Labels,
+			})
+		}
+	}
+}
+
+// validatePriority checks that priority is a single value and, when allowed
+// is not empty, one of allowed.
+func validatePriority(spec *Spec, allowed []string, result *ValidationResult) {
+	if spec.Frontmatter == nil {
+		return
+	}
+	node, ok := spec.Frontmatter.Fields["priority"]
+	if !ok {
+		return
+	}
+	if node.Kind != yaml.ScalarNode {
+		result.Errors = append(result.Errors, ValidationError{
+			Field:   "priority",
+			Message: "must be a single value",
+			Rule:    rules.Priority,
+		})
+		return
+	}
+	if len(allowed) > 0 && node.Value != "" && !slices.Contains(allowed, node.Value) {
+		result.Errors = append(result.Errors, ValidationError{
+			Field:   "priority",
+			Message: fmt.Sprintf("invalid value %q (must be one of: %s)", node.Value, strings.Join(allowed, ", ")),
+			Rule:    rules.Priority,
+		})
+	}
+}
+
+// hasFrontmatterValue reports whether field is set to a non-empty value.
+func hasFrontmatterValue(fm *Frontmatter, field string) bool {
+	node, ok := fm.Fields[field]
+	if !ok {
+		return false
+	}
+	return node.Tag != "!!null" && !(node.Kind == yaml.ScalarNode && strings.TrimSpace(node.Value) == "")
+}
+
 func firstNumberedSectionHeading(source []byte) (string, bool) {
 	lines := strings.Split(string(source), "\n")
 	for _, line := range lines {
@@ -107,18 +239,27 @@ func firstNumberedSectionHeading(source []byte) (string, bool) {
 	return "", false
 }
 
-// ValidateSpecs validates multiple specs, including cross-spec checks like duplicate full refs.
+// ValidateSpecs validates multiple specs, including cross-spec checks like
+// duplicate full refs, unknown dependency refs, dependency cycles, and
+// one-sided supersession relations. Specs
+// should include the whole tree so cross-spec references can be resolved.
 // Returns one ValidationResult per spec.
 func ValidateSpecs(specs []*Spec) []*ValidationResult {
+	return ValidateSpecsWithOptions(specs, DefaultOptions())
+}
+
+// ValidateSpecsWithOptions is ValidateSpecs with project-specific statuses,
+// required fields, and rule severities.
+func ValidateSpecsWithOptions(specs []*Spec, opts Options) []*ValidationResult {
 	results := make([]*ValidationResult, len(specs))
 	for i, spec := range specs {
-		results[i] = ValidateSpec(spec)
+		results[i] = validateSpec(spec, opts)
 	}
 
 	// Cross-spec: detect duplicate full refs.
 	refToIdx := make(map[string][]int)
 	for i, spec := range specs {
-		fullRef := fullRefFromPath(spec.Path)
+		fullRef := specFullRef(spec)
 		if fullRef != "" {
 			refToIdx[fullRef] = append(refToIdx[fullRef], i)
 		}
@@ -129,14 +270,33 @@ func ValidateSpecs(specs []*Spec) []*ValidationResult {
 				results[idx].Errors = append(results[idx].Errors, ValidationError{
 					Field:   "fullref",
 					Message: fmt.Sprintf("duplicate ref %s", fullRef),
+					Rule:    rules.DuplicateRef,
 				})
 			}
 		}
 	}
 
+	validateDependencies(specs, results, refToIdx)
+	validateSupersession(specs, results, refToIdx)
+	validateTransitions(specs, results, opts)
+
+	for _, result := range results {
+		applySeverities(result, opts)
+	}
 	return results
 }
 
+// ReadErrorResult reports a spec file that could not be read as a finding
+// of the read rule, so reports include the file like any invalid spec.
+func ReadErrorResult(path string, err error, opts Options) *ValidationResult {
+	result := &ValidationResult{
+		Path:   path,
+		Errors: []ValidationError{{Field: "file", Message: err.Error(), Rule: rules.Read}},
+	}
+	applySeverities(result, opts)
+	return result
+}
+
 // ValidateSpecFile parses and validates a spec file
 func ValidateSpecFile(path string) (*ValidationResult, error) {
 	spec, err := ParseSpec(path)
@@ -168,6 +328,18 @@ func FormatValidationResult(result *ValidationResult) string {
 	return output
 }
 
+// specFullRef derives the spec's hierarchical ref from its directory chain.
+func specFullRef(spec *Spec) string {
+	if spec.SpecsDir == "" {
+		return fullRefFromPath(spec.Path)
+	}
+	rel, err := filepath.Rel(spec.SpecsDir, spec.Path)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return ""
+	}
+	return fullRefFromParts(strings.Split(filepath.Dir(rel), string(filepath.Separator)))
+}
+
 func fullRefFromPath(path string) string {
 	cleaned := filepath.Clean(path)
 	parts := strings.Split(cleaned, string(filepath.Separator))
@@ -182,8 +354,14 @@ func fullRefFromPath(path string) string {
 		return ""
 	}
 
+	return fullRefFromParts(parts[specsIdx+1 : len(parts)-1])
+}
+
+// fullRefFromParts joins the leading numbers of spec directory names into a
+// ref, returning "" when any directory is not numbered.
+func fullRefFromParts(parts []string) string {
 	var refs []string
-	for _, part := range parts[specsIdx+1 : len(parts)-1] {
+	for _, part := range parts {
 		number := extractLeadingNumber(part)
 		if number < 0 {
 			return ""
@@ -748,3 +748,115 @@ status: draft
 		}
 	}
 }
+
+func TestValidateSpec_Assignee(t *testing.T) {
+	tests := []struct {
+		name      string
+		assignee  string
+		wantError string
+	}{
+		{"single name", "Alice Example", ""},
+		{"list of names", "[Alice Example, Bob Builder]", ""},
+		{"block list", "\n  - Alice Example\n  - Bob Builder", ""},
+		{"mapping", "{name: Alice}", "must be a name or a list of names"},
+		{"nested list", "[[Alice], Bob]", "must be a name or a list of names"},
+		{"null item", "[Alice, null]", "must be a name or a list of names"},
+		{"comma in name", `"Alice Example, Bob Builder"`, `name "Alice Example, Bob Builder" must not contain a comma`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := []byte("---\nstatus: draft\nassignee: " + tt.assignee + "\n---\n\n# My Feature\n")
+			spec, err := ParseSpecContent("specs/001-test/SPEC.md", content)
+			if err != nil {
+				t.Fatalf("unexpected parse error: %v", err)
+			}
+
+			messages := errorMessages(ValidateSpec(spec), "assignee")
+			if tt.wantError == "" {
+				if len(messages) != 0 {
+					t.Errorf("expected no assignee errors, got %v", messages)
+				}
+				return
+			}
+			if len(messages) != 1 || !strings.Contains(messages[0], tt.wantError) {
+				t.Errorf("expected assignee error %q, got %v", tt.wantError, messages)
+			}
+		})
+	}
+}
+
+func TestValidateSpec_Labels(t *testing.T) {
+	tests := []struct {
+		name      string
+		labels    string
+		allowed   []string
+		wantError string
+	}{
+		{"any label without vocabulary", "[cli, anything]", nil, ""},
+		{"allowed labels", "[cli, docs]", []string{"cli", "docs"}, ""},
+		{"scalar label", "cli", []string{"cli"}, ""},
+		{"unknown label", "[cli, dcos]", []string{"cli", "docs"}, `unknown label "dcos" (allowed: cli, docs)`},
+		{"mapping", "{area: cli}", nil, "must be a label or a list of labels"},
+		{"space in label", `"command line"`, nil, `invalid label "command line"`},
+		{"negated label", `"!docs"`, nil, `invalid label "!docs"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := []byte("---\nstatus: draft\nlabels: " + tt.labels + "\n---\n\n# My Feature\n")
+			spec, err := ParseSpecContent("specs/001-test/SPEC.md", content)
+			if err != nil {
+				t.Fatalf("unexpected parse error: %v", err)
+			}
+
+			opts := DefaultOptions()
+			opts.Labels = tt.allowed
+			messages := errorMessages(validateSpec(spec, opts), "labels")
+			if tt.wantError == "" {
+				if len(messages) != 0 {
+					t.Errorf("expected no label errors, got %v", messages)
+				}
+				return
+			}
+			if len(messages) != 1 || !strings.Contains(messages[0], tt.wantError) {
+				t.Errorf("expected label error %q, got %v", tt.wantError, messages)
+			}
+		})
+	}
+}
+
+func TestValidateSpec_Priority(t *testing.T) {
+	tests := []struct {
+		name      string
+		priority  string
+		wantError string
+	}{
+		{"allowed priority", "high", ""},
+		{"unknown priority", "urgent", `invalid value "urgent" (must be one of: critical, high, medium, low)`},
+		{"list", "[high, low]", "must be a single value"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			content := []byte("---\nstatus: draft\npriority: " + tt.priority + "\n---\n\n# My Feature\n")
+			spec, err := ParseSpecContent("specs/001-test/SPEC.md", content)
+			if err != nil {
+				t.Fatalf("unexpected parse error: %v", err)
+			}
+
+			opts := DefaultOptions()
+			opts.Priorities = []string{"critical", "high", "medium", "low"}
+			messages := errorMessages(validateSpec(spec, opts), "priority")
+			if tt.wantError == "" {
+				if len(messages) != 0 {
+					t.Errorf("expected no priority errors, got %v", messages)
+				}
+				return
+			}
+			if len(messages) != 1 || !strings.Contains(messages[0], tt.wantError) {
+				t.Errorf("expected priority error %q, got %v", tt.wantError, messages)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+// Package workflow describes the allowed status transitions for specs.
+package workflow
+
+import "slices"
+
+// Workflow is a status state machine. The zero value allows every
+// transition.
+type Workflow struct {
+	// Transitions maps a status to the statuses a spec may move to from it.
+	// When empty, every transition is allowed. A status that is not a key
+	// has no outgoing transitions.
+	Transitions map[string][]string
+	// Initial lists the statuses a new spec may start in. When empty, a new
+	// spec may start in any status.
+	Initial []string
+}
+
+// Enforced reports whether the workflow restricts transitions.
+func (w Workflow) Enforced() bool {
+	return len(w.Transitions) > 0
+}
+
+// Allows reports whether a spec may move from one status to another. Keeping
+// the same status is always allowed.
+func (w Workflow) Allows(from, to string) bool {
+	if from == to || !w.Enforced() {
+		return true
+	}
+	return slices.Contains(w.Transitions[from], to)
+}
+
+// AllowsInitial reports whether a new spec may start in status.
+func (w Workflow) AllowsInitial(status string) bool {
+	return len(w.Initial) == 0 || slices.Contains(w.Initial, status)
+}
+
+// Next returns the statuses a spec may move to from status, or nil when the
+// workflow is not enforced.
+func (w Workflow) Next(status string) []string {
+	if !w.Enforced() {
+		return nil
+	}
+	return w.Transitions[status]
+}
@@ -0,0 +1,47 @@
+package workflow
+
+import "testing"
+
+func TestWorkflow_Allows(t *testing.T) {
+	w := Workflow{
+		Transitions: map[string][]string{
+			"draft":       {"approved", "rejected"},
+			"approved":    {"in-progress"},
+			"in-progress": {"completed"},
+		},
+		Initial: []string{"draft"},
+	}
+
+	tests := []struct {
+		from, to string
+		want     bool
+	}{
+		{"draft", "approved", true},
+		{"draft", "draft", true},
+		{"draft", "completed", false},
+		{"approved", "in-progress", true},
+		{"completed", "draft", false},
+	}
+	for _, tt := range tests {
+		if got := w.Allows(tt.from, tt.to); got != tt.want {
+			t.Errorf("Allows(%q, %q) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+
+	if !w.AllowsInitial("draft") || w.AllowsInitial("approved") {
+		t.Error("expected only draft as an initial status")
+	}
+}
+
+func TestWorkflow_ZeroValueAllowsEverything(t *testing.T) {
+	var w Workflow
+	if w.Enforced() {
+		t.Error("expected zero workflow not to be enforced")
+	}
+	if !w.Allows("draft", "completed") || !w.AllowsInitial("completed") {
+		t.Error("expected zero workflow to allow every transition")
+	}
+	if w.Next("draft") != nil {
+		t.Error("expected no next statuses for an unenforced workflow")
+	}
+}